@@ -60,6 +60,8 @@ type LinuxDoOAuthConfig struct {
 	ClientID     string `yaml:"client_id"`
 	ClientSecret string `yaml:"client_secret"`
 	RedirectURI  string `yaml:"redirect_uri"`
+	// BlockRestricted 是否禁止被禁言或未激活的LinuxDo用户登录(默认仅禁止投票和审核)
+	BlockRestricted bool `yaml:"block_restricted"`
 }
 
 var (
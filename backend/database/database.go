@@ -30,20 +30,41 @@ func Init(dbPath string) error {
 	}
 
 	// 自动迁移数据库表结构
-	if err = autoMigrate(); err != nil {
+	if err = Migrate(DB); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// autoMigrate 自动迁移数据库表
-func autoMigrate() error {
-	return DB.AutoMigrate(
+// Migrate 自动迁移数据库表
+func Migrate(db *gorm.DB) error {
+	return db.AutoMigrate(
 		&models.User{},
 		&models.Post{},
 		&models.Vote{},
 		&models.SystemConfig{},
+		&models.APIToken{},
+		&models.PostRevision{},
+		&models.PostEvent{},
+		&models.ApplyExemption{},
+		&models.Appeal{},
+		&models.AppealNote{},
+		&models.Comment{},
+		&models.ApplicationForm{},
+		&models.PostSignature{},
+		&models.PostSimilarity{},
+		&models.ContentRule{},
+		&models.Report{},
+		&models.VoteCluster{},
+		&models.VoteRingDiscount{},
+		&models.VoterReputation{},
+		&models.ReviewDecision{},
+		&models.ReviewerNote{},
+		&models.ReviewLock{},
+		&models.ReviewSkip{},
+		&models.UserDevice{},
+		&models.UserRelationship{},
 	)
 }
 
@@ -1,5 +1,7 @@
 package dto
 
+import "linuxdo-review/models"
+
 // RegisterRequest 注册请求
 type RegisterRequest struct {
 	Email    string `json:"email" binding:"required,email"`
@@ -14,30 +16,119 @@ type LoginRequest struct {
 }
 
 // CreatePostRequest 创建帖子请求
+// 配置了申请表时通过 Answers 按问题标识填写，否则使用 Content 自由填写
 type CreatePostRequest struct {
-	Title   string `json:"title" binding:"required,min=5,max=200"`
-	Content string `json:"content" binding:"required,min=50"`
+	Title   string            `json:"title" binding:"required,min=5,max=200"`
+	Content string            `json:"content" binding:"omitempty,max=20000"`
+	Answers map[string]string `json:"answers"`
 }
 
-// UpdatePostRequest 更新帖子请求
+// UpdatePostRequest 更新帖子请求(按申请表填写的申请只修改提交的回答)
 type UpdatePostRequest struct {
-	Title   string `json:"title" binding:"omitempty,min=5,max=200"`
-	Content string `json:"content" binding:"omitempty,min=50"`
+	Title   string            `json:"title" binding:"omitempty,min=5,max=200"`
+	Content string            `json:"content" binding:"omitempty,min=50"`
+	Answers map[string]string `json:"answers"`
+}
+
+// PublishFormRequest 发布申请表请求(问题为空表示恢复自由填写)
+type PublishFormRequest struct {
+	Questions []models.FormQuestion `json:"questions"`
 }
 
 // VoteRequest 投票请求
 type VoteRequest struct {
-	VoteType int `json:"vote_type" binding:"required,oneof=1 -1"`
+	VoteType       int    `json:"vote_type" binding:"required,oneof=1 -1"`
+	ReasonCategory string `json:"reason_category" binding:"omitempty,max=50"` // 反对原因分类(仅反对票)
+	Reason         string `json:"reason" binding:"omitempty,max=500"`         // 反对原因说明(仅反对票)
+}
+
+// CreateCommentRequest 发表评论请求
+type CreateCommentRequest struct {
+	Content  string `json:"content" binding:"required,max=5000"`
+	ParentID *uint  `json:"parent_id" binding:"omitempty,min=1"` // 回复的评论ID，为空表示顶层评论
+}
+
+// UpdateCommentRequest 修改评论请求
+type UpdateCommentRequest struct {
+	Content string `json:"content" binding:"required,max=5000"`
+}
+
+// SetCommentHiddenRequest 隐藏/恢复评论请求
+type SetCommentHiddenRequest struct {
+	Hidden *bool `json:"hidden" binding:"required"`
+}
+
+// SetCommentsLockedRequest 锁定/解锁评论区请求
+type SetCommentsLockedRequest struct {
+	Locked *bool `json:"locked" binding:"required"`
+}
+
+// WithdrawRequest 撤回申请请求
+type WithdrawRequest struct {
+	Reason string `json:"reason" binding:"omitempty,max=500"`
+}
+
+// CreateAppealRequest 提交申诉请求
+type CreateAppealRequest struct {
+	Statement string `json:"statement" binding:"required,min=20,max=5000"`
+}
+
+// AcceptAppealRequest 接受申诉请求
+// TargetStatus: 1=重新进入社区投票(清空原投票), 2=直接进入二级审核
+type AcceptAppealRequest struct {
+	TargetStatus int    `json:"target_status" binding:"required,oneof=1 2"`
+	Note         string `json:"note" binding:"omitempty,max=500"`
+}
+
+// DenyAppealRequest 驳回申诉请求
+type DenyAppealRequest struct {
+	Note string `json:"note" binding:"omitempty,max=500"`
+}
+
+// AppealNoteRequest 添加申诉备注请求
+type AppealNoteRequest struct {
+	Content string `json:"content" binding:"required,max=2000"`
+}
+
+// SkipReviewRequest 跳过申请请求
+type SkipReviewRequest struct {
+	ExpireHours *int `json:"expire_hours" binding:"omitempty,min=0,max=8760"` // 多少小时后重新出现(0表示不过期，不填使用系统配置)
+}
+
+// PriorityBoostRequest 设置审核队列优先级加成请求
+type PriorityBoostRequest struct {
+	Boost *float64 `json:"boost" binding:"required,min=-1000,max=1000"` // 加成分数(可为负，0表示取消加成)
+}
+
+// ReviewerNoteRequest 添加审核员备注请求
+type ReviewerNoteRequest struct {
+	Content string `json:"content" binding:"required,max=2000"`
+}
+
+// CreateReportRequest 提交举报请求
+type CreateReportRequest struct {
+	TargetType models.ReportTargetType `json:"target_type" binding:"required,oneof=post comment user"`
+	TargetID   uint                    `json:"target_id" binding:"required"`
+	Category   string                  `json:"category" binding:"required,max=50"`
+	Note       string                  `json:"note" binding:"omitempty,max=1000"`
+}
+
+// HandleReportRequest 处理举报请求
+type HandleReportRequest struct {
+	Note string `json:"note" binding:"omitempty,max=500"`
 }
 
 // ApproveRequest 审核通过请求(提交邀请码)
+// 多人审核模式下只有最后一名同意的审核员需要提交邀请码
 type ApproveRequest struct {
-	InviteCode string `json:"invite_code" binding:"required,min=5"`
+	InviteCode string `json:"invite_code" binding:"omitempty,min=5"`
+	Note       string `json:"note" binding:"omitempty,max=1000"` // 审核意见说明(仅审核员可见)
 }
 
 // RejectRequest 拒绝申请请求
 type RejectRequest struct {
 	Reason string `json:"reason" binding:"omitempty,max=500"`
+	Note   string `json:"note" binding:"omitempty,max=1000"` // 审核意见说明(仅审核员可见)
 }
 
 // UpdateUserRoleRequest 更新用户角色请求
@@ -45,6 +136,12 @@ type UpdateUserRoleRequest struct {
 	Role int `json:"role" binding:"oneof=0 1 2"`
 }
 
+// GrantExemptionRequest 授予申请限制豁免请求
+type GrantExemptionRequest struct {
+	Reason        string `json:"reason" binding:"omitempty,max=500"`
+	ExpiresInDays int    `json:"expires_in_days" binding:"omitempty,min=1,max=3650"` // 有效天数，为空表示永久有效
+}
+
 // UpdateConfigRequest 更新配置请求
 type UpdateConfigRequest struct {
 	Key   string `json:"key" binding:"required"`
@@ -84,13 +181,35 @@ func (p *PaginationRequest) GetOffset() int {
 }
 
 // PostListRequest 帖子列表请求
-// Status: -1=投票中+待审核, 0=待审核, 1=投票中, 2=二级审核, 3=已通过, 4=已拒绝
+// Status: -1=投票中+待审核, 0=待审核, 1=投票中, 2=二级审核, 3=已通过, 4=已拒绝, 5=已撤回
 type PostListRequest struct {
 	PaginationRequest
-	Status *int   `form:"status" binding:"omitempty,oneof=-1 0 1 2 3 4"`
+	Status *int   `form:"status" binding:"omitempty,oneof=-1 0 1 2 3 4 5"`
 	Search string `form:"search" binding:"omitempty,max=100"`
 }
 
+// AppealListRequest 申诉列表请求
+// Status: 0=待处理, 1=已接受, 2=已驳回，为空表示全部
+type AppealListRequest struct {
+	PaginationRequest
+	Status *int `form:"status" binding:"omitempty,oneof=0 1 2"`
+}
+
+// ReportListRequest 举报列表请求
+// Status: 0=待处理, 1=已处理, 2=已驳回，为空表示全部
+type ReportListRequest struct {
+	PaginationRequest
+	Status     *int   `form:"status" binding:"omitempty,oneof=0 1 2"`
+	TargetType string `form:"target_type" binding:"omitempty,oneof=post comment user"`
+}
+
+// VoteClusterListRequest 投票圈列表请求
+// Status: 0=待处理, 1=已作废投票, 2=已忽略，为空表示全部
+type VoteClusterListRequest struct {
+	PaginationRequest
+	Status *int `form:"status" binding:"omitempty,oneof=0 1 2"`
+}
+
 // UserListRequest 用户列表请求
 type UserListRequest struct {
 	PaginationRequest
@@ -138,3 +257,41 @@ type ChangeEmailRequest struct {
 type UpdateAvatarRequest struct {
 	AvatarURL string `json:"avatar_url" binding:"required,url"`
 }
+
+// CreateTokenRequest 创建个人访问令牌请求
+type CreateTokenRequest struct {
+	Name          string   `json:"name" binding:"required,min=1,max=100"`
+	Scopes        []string `json:"scopes" binding:"required,min=1,dive,oneof=read:posts review admin:read"`
+	ExpiresInDays int      `json:"expires_in_days" binding:"omitempty,min=1,max=365"` // 有效天数，为空表示永不过期
+}
+
+// ContentRuleRequest 创建/更新内容规则请求
+type ContentRuleRequest struct {
+	Name      string                   `json:"name" binding:"required,max=100"`
+	Type      models.ContentRuleType   `json:"type" binding:"required"`
+	Pattern   string                   `json:"pattern"`
+	Threshold float64                  `json:"threshold"`
+	Action    models.ContentRuleAction `json:"action" binding:"required"`
+	Message   string                   `json:"message" binding:"max=255"`
+	Enabled   *bool                    `json:"enabled"` // 不填默认启用
+}
+
+// TestContentRulesRequest 试运行内容规则请求(不填规则时使用所有已启用的规则)
+type TestContentRulesRequest struct {
+	Title   string              `json:"title"`
+	Content string              `json:"content" binding:"required"`
+	Rule    *ContentRuleRequest `json:"rule"`
+}
+
+// UserRelationshipListRequest 用户关系列表请求(user_id 为空表示全部)
+type UserRelationshipListRequest struct {
+	PaginationRequest
+	UserID uint `form:"user_id"`
+}
+
+// UserRelationshipRequest 登记用户关系请求
+type UserRelationshipRequest struct {
+	UserID        uint   `json:"user_id" binding:"required"`
+	RelatedUserID uint   `json:"related_user_id" binding:"required"`
+	Note          string `json:"note" binding:"max=500"`
+}
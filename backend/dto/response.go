@@ -1,6 +1,9 @@
 package dto
 
-import "linuxdo-review/models"
+import (
+	"linuxdo-review/models"
+	"linuxdo-review/pkg/textdiff"
+)
 
 // UserResponse 用户响应
 type UserResponse struct {
@@ -13,6 +16,8 @@ type UserResponse struct {
 	LinuxDoUsername string          `json:"linuxdo_username,omitempty"`
 	AvatarURL       string          `json:"avatar_url,omitempty"`
 	TrustLevel      int             `json:"trust_level,omitempty"`
+	LinuxDoSilenced bool            `json:"linuxdo_silenced"` // LinuxDo账号是否被禁言
+	LinuxDoInactive bool            `json:"linuxdo_inactive"` // LinuxDo账号是否未激活
 	IsCertified     bool            `json:"is_certified"`
 	IsAdmin         bool            `json:"is_admin"`
 	CreatedAt       string          `json:"created_at"`
@@ -44,12 +49,32 @@ func ToUserResponse(user *models.User) *UserResponse {
 		LinuxDoUsername: user.LinuxDoUsername,
 		AvatarURL:       user.AvatarURL,
 		TrustLevel:      user.TrustLevel,
+		LinuxDoSilenced: user.LinuxDoSilenced,
+		LinuxDoInactive: user.LinuxDoInactive,
 		IsCertified:     user.IsCertified(),
 		IsAdmin:         user.IsAdmin(),
 		CreatedAt:       user.CreatedAt.Format("2006-01-02 15:04:05"),
 	}
 }
 
+// PublicUserResponse 公开的用户信息(无需登录即可查看的接口使用，不包含邮箱等隐私信息)
+type PublicUserResponse struct {
+	ID         uint   `json:"id"`
+	Username   string `json:"username"`
+	AvatarURL  string `json:"avatar_url,omitempty"`
+	TrustLevel int    `json:"trust_level,omitempty"`
+}
+
+// ToPublicUserResponse 转换为公开的用户信息
+func ToPublicUserResponse(user *models.User) *PublicUserResponse {
+	return &PublicUserResponse{
+		ID:         user.ID,
+		Username:   user.Username,
+		AvatarURL:  user.AvatarURL,
+		TrustLevel: user.TrustLevel,
+	}
+}
+
 // ToUserResponseList 批量转换为用户响应列表
 func ToUserResponseList(users []*models.User) []*UserResponse {
 	list := make([]*UserResponse, len(users))
@@ -65,28 +90,78 @@ type LoginResponse struct {
 	User  *UserResponse `json:"user"`
 }
 
+// ApplyStatusResponse 申请资格状态响应
+type ApplyStatusResponse struct {
+	CanApply          bool   `json:"can_apply"`                // 冷却期和申请次数是否允许发起新申请
+	CooldownUntil     string `json:"cooldown_until,omitempty"` // 冷却结束时间
+	RejectionCount    int64  `json:"rejection_count"`
+	AttemptCount      int64  `json:"attempt_count"`
+	MaxAttempts       int    `json:"max_attempts"`       // 0表示不限制
+	AttemptsRemaining int    `json:"attempts_remaining"` // -1表示不限制
+	Exempt            bool   `json:"exempt"`
+	ExemptUntil       string `json:"exempt_until,omitempty"`
+}
+
+// MeResponse 当前用户信息响应(包含申请资格状态和投票者信誉)
+type MeResponse struct {
+	*UserResponse
+	ApplyStatus *ApplyStatusResponse `json:"apply_status,omitempty"`
+	Reputation  *ReputationResponse  `json:"reputation,omitempty"`
+}
+
+// ReputationResponse 投票者信誉响应
+type ReputationResponse struct {
+	UserID     uint                `json:"user_id"`
+	User       *PublicUserResponse `json:"user,omitempty"`
+	Score      float64             `json:"score"`   // 当前分数(已按时间衰减)
+	Aligned    int                 `json:"aligned"` // 与最终结果一致的投票数
+	Misaligned int                 `json:"misaligned"`
+	Accuracy   float64             `json:"accuracy"` // 投票准确率(百分比)
+	Weight     float64             `json:"weight"`   // 开启信誉加权时的投票权重
+}
+
 // PostResponse 帖子响应
 type PostResponse struct {
-	ID           uint              `json:"id"`
-	UserID       uint              `json:"user_id"`
-	User         *UserResponse     `json:"user,omitempty"`
-	Title        string            `json:"title"`
-	Content      string            `json:"content"`
-	Status       models.PostStatus `json:"status"`
-	StatusText   string            `json:"status_text"`
-	UpVotes      int               `json:"up_votes"`
-	DownVotes    int               `json:"down_votes"`
-	TotalVotes   int               `json:"total_votes"`
-	ApprovalRate float64           `json:"approval_rate"`
-	ReviewerID   *uint             `json:"reviewer_id,omitempty"`
-	Reviewer     *UserResponse     `json:"reviewer,omitempty"`
-	RejectReason string            `json:"reject_reason,omitempty"`
-	ReviewedAt   string            `json:"reviewed_at,omitempty"`
-	CreatedAt    string            `json:"created_at"`
-	UpdatedAt    string            `json:"updated_at"`
-	MyVote       int               `json:"my_vote,omitempty"` // 当前用户的投票: 1赞, -1踩, 0未投票
-	CanVote      bool              `json:"can_vote"`          // 是否可以投票
-	CanApprove   bool              `json:"can_approve"`       // 是否可以通过
+	ID                uint                    `json:"id"`
+	UserID            uint                    `json:"user_id"`
+	User              *UserResponse           `json:"user,omitempty"`
+	Title             string                  `json:"title"`
+	Content           string                  `json:"content"`      // Markdown 原文
+	ContentHTML       string                  `json:"content_html"` // 渲染并过滤后的安全 HTML
+	Status            models.PostStatus       `json:"status"`
+	StatusText        string                  `json:"status_text"`
+	UpVotes           int                     `json:"up_votes"`
+	DownVotes         int                     `json:"down_votes"`
+	TotalVotes        int                     `json:"total_votes"`
+	ApprovalRate      float64                 `json:"approval_rate"`
+	TallyHidden       bool                    `json:"tally_hidden,omitempty"` // 盲投模式下票数是否被隐藏
+	ReviewerID        *uint                   `json:"reviewer_id,omitempty"`
+	Reviewer          *UserResponse           `json:"reviewer,omitempty"`
+	RejectReason      string                  `json:"reject_reason,omitempty"`
+	ReviewedAt        string                  `json:"reviewed_at,omitempty"`
+	EditCount         int                     `json:"edit_count"`                    // 修改次数
+	EditedAt          string                  `json:"edited_at,omitempty"`           // 最后修改时间
+	CommentCount      int                     `json:"comment_count"`                 // 可见评论数
+	CommentsLocked    bool                    `json:"comments_locked"`               // 评论区是否被锁定
+	FormVersion       int                     `json:"form_version"`                  // 申请表版本，0表示自由填写
+	Answers           []models.PostAnswer     `json:"answers,omitempty"`             // 申请表回答(按问题顺序)
+	SimilarityScore   float64                 `json:"similarity_score"`              // 与其他用户历史申请的最高相似度(百分比)
+	PossibleDuplicate bool                    `json:"possible_duplicate"`            // 疑似重复
+	HoldReason        string                  `json:"hold_reason,omitempty"`         // 被自动暂停的原因(仅认证用户和管理员可见)
+	RuleFlags         string                  `json:"rule_flags,omitempty"`          // 命中的标记类内容规则(仅认证用户和管理员可见)
+	Escalated         bool                    `json:"escalated,omitempty"`           // 二级审核超过时限被升级(审核队列中突出显示)
+	EscalatedAt       string                  `json:"escalated_at,omitempty"`        // 本轮被升级的时间
+	EscalationCount   int                     `json:"escalation_count,omitempty"`    // 累计被升级的次数
+	SLAFallbackAt     string                  `json:"sla_fallback_at,omitempty"`     // 本轮执行兜底操作的时间
+	SLAFallbackAction string                  `json:"sla_fallback_action,omitempty"` // 执行的兜底操作
+	SimilarPosts      []*SimilarPostResponse  `json:"similar_posts,omitempty"`       // 相似的历史申请(仅详情返回)
+	ReviewerNotes     []*ReviewerNoteResponse `json:"reviewer_notes,omitempty"`      // 审核员内部备注(仅认证用户和管理员可见)
+	CreatedAt         string                  `json:"created_at"`
+	UpdatedAt         string                  `json:"updated_at"`
+	MyVote            int                     `json:"my_vote,omitempty"`       // 当前用户的投票: 1赞, -1踩, 0未投票
+	MyVoteStale       bool                    `json:"my_vote_stale,omitempty"` // 当前用户的投票是否在申请实质性修改之前
+	CanVote           bool                    `json:"can_vote"`                // 是否可以投票
+	CanApprove        bool                    `json:"can_approve"`             // 是否可以通过
 }
 
 // GetStatusText 获取状态文本
@@ -102,36 +177,77 @@ func GetStatusText(status models.PostStatus) string {
 		return "已通过"
 	case models.StatusRejected:
 		return "已拒绝"
+	case models.StatusWithdrawn:
+		return "已撤回"
 	default:
 		return "未知"
 	}
 }
 
-// ToPostResponse 转换为帖子响应
+// HideTally 隐藏票数和赞率(盲投模式)
+func (r *PostResponse) HideTally() {
+	r.UpVotes = 0
+	r.DownVotes = 0
+	r.TotalVotes = 0
+	r.ApprovalRate = 0
+	r.TallyHidden = true
+}
+
+// ShowModerationInfo 填充暂停原因和内容规则命中信息
+// 这些信息可能暴露内部规则，只返回给认证用户和管理员
+func (r *PostResponse) ShowModerationInfo(post *models.Post) {
+	r.HoldReason = post.HoldReason
+	r.RuleFlags = post.RuleFlags
+}
+
+// ToPostResponse 转换为帖子响应(不包含仅认证用户和管理员可见的暂停原因和规则命中信息)
 func ToPostResponse(post *models.Post) *PostResponse {
 	resp := &PostResponse{
-		ID:           post.ID,
-		UserID:       post.UserID,
-		Title:        post.Title,
-		Content:      post.Content,
-		Status:       post.Status,
-		StatusText:   GetStatusText(post.Status),
-		UpVotes:      post.UpVotes,
-		DownVotes:    post.DownVotes,
-		TotalVotes:   post.TotalVotes(),
-		ApprovalRate: post.ApprovalRate(),
-		ReviewerID:   post.ReviewerID,
-		RejectReason: post.RejectReason,
-		CreatedAt:    post.CreatedAt.Format("2006-01-02 15:04:05"),
-		UpdatedAt:    post.UpdatedAt.Format("2006-01-02 15:04:05"),
-		CanVote:      post.CanVote(),
-		CanApprove:   post.CanApprove(),
+		ID:                post.ID,
+		UserID:            post.UserID,
+		Title:             post.Title,
+		Content:           post.Content,
+		ContentHTML:       post.ContentHTML,
+		Status:            post.Status,
+		StatusText:        GetStatusText(post.Status),
+		UpVotes:           post.UpVotes,
+		DownVotes:         post.DownVotes,
+		TotalVotes:        post.TotalVotes(),
+		ApprovalRate:      post.ApprovalRate(),
+		ReviewerID:        post.ReviewerID,
+		RejectReason:      post.RejectReason,
+		EditCount:         post.EditCount,
+		CommentCount:      post.CommentCount,
+		CommentsLocked:    post.CommentsLocked,
+		FormVersion:       post.FormVersion,
+		Answers:           post.Answers,
+		SimilarityScore:   post.SimilarityScore,
+		PossibleDuplicate: post.PossibleDuplicate,
+		Escalated:         post.IsEscalated(),
+		EscalationCount:   post.EscalationCount,
+		SLAFallbackAction: post.SLAFallbackAction,
+		CreatedAt:         post.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:         post.UpdatedAt.Format("2006-01-02 15:04:05"),
+		CanVote:           post.CanVote(),
+		CanApprove:        post.CanApprove(),
 	}
 
 	if post.ReviewedAt != nil {
 		resp.ReviewedAt = post.ReviewedAt.Format("2006-01-02 15:04:05")
 	}
 
+	if post.EditedAt != nil {
+		resp.EditedAt = post.EditedAt.Format("2006-01-02 15:04:05")
+	}
+
+	if post.EscalatedAt != nil {
+		resp.EscalatedAt = post.EscalatedAt.Format("2006-01-02 15:04:05")
+	}
+
+	if post.SLAFallbackAt != nil {
+		resp.SLAFallbackAt = post.SLAFallbackAt.Format("2006-01-02 15:04:05")
+	}
+
 	if post.User != nil {
 		resp.User = ToUserResponse(post.User)
 	}
@@ -152,6 +268,435 @@ func ToPostResponseList(posts []*models.Post) []*PostResponse {
 	return list
 }
 
+// SimilarPostResponse 相似申请响应
+type SimilarPostResponse struct {
+	PostID     uint              `json:"post_id"`
+	Title      string            `json:"title"`
+	Status     models.PostStatus `json:"status"`
+	StatusText string            `json:"status_text"`
+	Username   string            `json:"username,omitempty"`
+	Score      float64           `json:"score"` // 相似度(百分比)
+}
+
+// ToSimilarPostResponseList 批量转换为相似申请响应列表
+func ToSimilarPostResponseList(matches []*models.PostSimilarity) []*SimilarPostResponse {
+	list := make([]*SimilarPostResponse, 0, len(matches))
+	for _, match := range matches {
+		if match.MatchPost == nil {
+			continue
+		}
+		item := &SimilarPostResponse{
+			PostID:     match.MatchPostID,
+			Title:      match.MatchPost.Title,
+			Status:     match.MatchPost.Status,
+			StatusText: GetStatusText(match.MatchPost.Status),
+			Score:      match.Score,
+		}
+		if match.MatchPost.User != nil {
+			item.Username = match.MatchPost.User.Username
+		}
+		list = append(list, item)
+	}
+	return list
+}
+
+// RevisionResponse 帖子修订记录响应
+type RevisionResponse struct {
+	ID          uint          `json:"id"`
+	PostID      uint          `json:"post_id"`
+	Version     int           `json:"version"`
+	EditorID    uint          `json:"editor_id"`
+	Editor      *UserResponse `json:"editor,omitempty"`
+	Title       string        `json:"title"`
+	Content     string        `json:"content"`
+	ChangeRate  float64       `json:"change_rate"`
+	Substantial bool          `json:"substantial"`
+	VotePolicy  string        `json:"vote_policy,omitempty"`
+	CreatedAt   string        `json:"created_at"`
+}
+
+// ToRevisionResponse 转换为修订记录响应
+func ToRevisionResponse(revision *models.PostRevision) *RevisionResponse {
+	resp := &RevisionResponse{
+		ID:          revision.ID,
+		PostID:      revision.PostID,
+		Version:     revision.Version,
+		EditorID:    revision.EditorID,
+		Title:       revision.Title,
+		Content:     revision.Content,
+		ChangeRate:  revision.ChangeRate,
+		Substantial: revision.Substantial,
+		VotePolicy:  revision.VotePolicy,
+		CreatedAt:   revision.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+	if revision.Editor != nil {
+		resp.Editor = ToUserResponse(revision.Editor)
+	}
+	return resp
+}
+
+// ToRevisionResponseList 批量转换为修订记录响应列表
+func ToRevisionResponseList(revisions []*models.PostRevision) []*RevisionResponse {
+	list := make([]*RevisionResponse, len(revisions))
+	for i, revision := range revisions {
+		list[i] = ToRevisionResponse(revision)
+	}
+	return list
+}
+
+// RevisionDiffResponse 修订版本差异响应(与上一版本对比)
+type RevisionDiffResponse struct {
+	Revision        *RevisionResponse `json:"revision"`
+	PreviousVersion int               `json:"previous_version"` // 上一版本号，原始版本为0
+	TitleDiff       []textdiff.Line   `json:"title_diff"`
+	ContentDiff     []textdiff.Line   `json:"content_diff"`
+}
+
+// ToRevisionDiffResponse 转换为修订版本差异响应
+func ToRevisionDiffResponse(revision, previous *models.PostRevision) *RevisionDiffResponse {
+	oldTitle, oldContent := "", ""
+	resp := &RevisionDiffResponse{Revision: ToRevisionResponse(revision)}
+	if previous != nil {
+		resp.PreviousVersion = previous.Version
+		oldTitle, oldContent = previous.Title, previous.Content
+	}
+	resp.TitleDiff = textdiff.Lines(oldTitle, revision.Title)
+	resp.ContentDiff = textdiff.Lines(oldContent, revision.Content)
+	return resp
+}
+
+// PostEventResponse 帖子历史事件响应
+type PostEventResponse struct {
+	ID        uint          `json:"id"`
+	PostID    uint          `json:"post_id"`
+	UserID    uint          `json:"user_id"`
+	User      *UserResponse `json:"user,omitempty"`
+	Action    string        `json:"action"`
+	Note      string        `json:"note,omitempty"`
+	CreatedAt string        `json:"created_at"`
+}
+
+// ToPostEventResponseList 批量转换为帖子历史事件响应列表
+func ToPostEventResponseList(events []*models.PostEvent) []*PostEventResponse {
+	list := make([]*PostEventResponse, len(events))
+	for i, event := range events {
+		list[i] = &PostEventResponse{
+			ID:        event.ID,
+			PostID:    event.PostID,
+			UserID:    event.UserID,
+			Action:    event.Action,
+			Note:      event.Note,
+			CreatedAt: event.CreatedAt.Format("2006-01-02 15:04:05"),
+		}
+		if event.User != nil {
+			list[i].User = ToUserResponse(event.User)
+		}
+	}
+	return list
+}
+
+// AppealResponse 申诉响应
+type AppealResponse struct {
+	ID           uint                  `json:"id"`
+	PostID       uint                  `json:"post_id"`
+	Post         *PostResponse         `json:"post,omitempty"`
+	UserID       uint                  `json:"user_id"`
+	User         *UserResponse         `json:"user,omitempty"`
+	Statement    string                `json:"statement"`
+	Status       models.AppealStatus   `json:"status"`
+	StatusText   string                `json:"status_text"`
+	Handler      *UserResponse         `json:"handler,omitempty"`
+	DecisionNote string                `json:"decision_note,omitempty"`
+	ReopenedTo   *models.PostStatus    `json:"reopened_to,omitempty"`
+	DecidedAt    string                `json:"decided_at,omitempty"`
+	CreatedAt    string                `json:"created_at"`
+	Notes        []*AppealNoteResponse `json:"notes,omitempty"`
+}
+
+// AppealNoteResponse 申诉内部备注响应
+type AppealNoteResponse struct {
+	ID        uint          `json:"id"`
+	UserID    uint          `json:"user_id"`
+	User      *UserResponse `json:"user,omitempty"`
+	Content   string        `json:"content"`
+	CreatedAt string        `json:"created_at"`
+}
+
+// ToAppealResponse 转换为申诉响应
+func ToAppealResponse(appeal *models.Appeal) *AppealResponse {
+	resp := &AppealResponse{
+		ID:           appeal.ID,
+		PostID:       appeal.PostID,
+		UserID:       appeal.UserID,
+		Statement:    appeal.Statement,
+		Status:       appeal.Status,
+		StatusText:   getAppealStatusText(appeal.Status),
+		DecisionNote: appeal.DecisionNote,
+		ReopenedTo:   appeal.ReopenedTo,
+		CreatedAt:    appeal.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+
+	if appeal.Post != nil {
+		resp.Post = ToPostResponse(appeal.Post)
+	}
+	if appeal.User != nil {
+		resp.User = ToUserResponse(appeal.User)
+	}
+	if appeal.Handler != nil {
+		resp.Handler = ToUserResponse(appeal.Handler)
+	}
+	if appeal.DecidedAt != nil {
+		resp.DecidedAt = appeal.DecidedAt.Format("2006-01-02 15:04:05")
+	}
+
+	return resp
+}
+
+// ToAppealResponseList 批量转换为申诉响应列表
+func ToAppealResponseList(appeals []*models.Appeal) []*AppealResponse {
+	list := make([]*AppealResponse, len(appeals))
+	for i, appeal := range appeals {
+		list[i] = ToAppealResponse(appeal)
+	}
+	return list
+}
+
+// ToAppealNoteResponse 转换为申诉备注响应
+func ToAppealNoteResponse(note *models.AppealNote) *AppealNoteResponse {
+	resp := &AppealNoteResponse{
+		ID:        note.ID,
+		UserID:    note.UserID,
+		Content:   note.Content,
+		CreatedAt: note.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+	if note.User != nil {
+		resp.User = ToUserResponse(note.User)
+	}
+	return resp
+}
+
+// getAppealStatusText 获取申诉状态文本
+func getAppealStatusText(status models.AppealStatus) string {
+	switch status {
+	case models.AppealPending:
+		return "待处理"
+	case models.AppealAccepted:
+		return "已接受"
+	case models.AppealDenied:
+		return "已驳回"
+	default:
+		return "未知"
+	}
+}
+
+// ReportResponse 举报响应
+type ReportResponse struct {
+	ID         uint                    `json:"id"`
+	ReporterID uint                    `json:"reporter_id"`
+	Reporter   *UserResponse           `json:"reporter,omitempty"`
+	TargetType models.ReportTargetType `json:"target_type"`
+	TargetID   uint                    `json:"target_id"`
+	PostID     uint                    `json:"post_id,omitempty"`
+	Category   string                  `json:"category"`
+	Note       string                  `json:"note,omitempty"`
+	Status     models.ReportStatus     `json:"status"`
+	StatusText string                  `json:"status_text"`
+	Handler    *UserResponse           `json:"handler,omitempty"`
+	Resolution string                  `json:"resolution,omitempty"`
+	HandledAt  string                  `json:"handled_at,omitempty"`
+	CreatedAt  string                  `json:"created_at"`
+}
+
+// ToReportResponse 转换为举报响应
+func ToReportResponse(report *models.Report) *ReportResponse {
+	resp := &ReportResponse{
+		ID:         report.ID,
+		ReporterID: report.ReporterID,
+		TargetType: report.TargetType,
+		TargetID:   report.TargetID,
+		PostID:     report.PostID,
+		Category:   report.Category,
+		Note:       report.Note,
+		Status:     report.Status,
+		StatusText: getReportStatusText(report.Status),
+		Resolution: report.Resolution,
+		CreatedAt:  report.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+
+	if report.Reporter != nil {
+		resp.Reporter = ToUserResponse(report.Reporter)
+	}
+	if report.Handler != nil {
+		resp.Handler = ToUserResponse(report.Handler)
+	}
+	if report.HandledAt != nil {
+		resp.HandledAt = report.HandledAt.Format("2006-01-02 15:04:05")
+	}
+
+	return resp
+}
+
+// ToReportResponseList 批量转换为举报响应列表
+func ToReportResponseList(reports []*models.Report) []*ReportResponse {
+	list := make([]*ReportResponse, len(reports))
+	for i, report := range reports {
+		list[i] = ToReportResponse(report)
+	}
+	return list
+}
+
+// getReportStatusText 获取举报状态文本
+func getReportStatusText(status models.ReportStatus) string {
+	switch status {
+	case models.ReportOpen:
+		return "待处理"
+	case models.ReportResolved:
+		return "已处理"
+	case models.ReportDismissed:
+		return "已驳回"
+	default:
+		return "未知"
+	}
+}
+
+// VoteClusterResponse 投票圈响应
+type VoteClusterResponse struct {
+	ID           uint                     `json:"id"`
+	MemberIDs    []uint                   `json:"member_ids"`
+	Members      []*UserResponse          `json:"members,omitempty"` // 仅详情返回
+	Pairs        []models.VoteClusterPair `json:"pairs"`
+	PostIDs      []uint                   `json:"post_ids"`
+	AvgAgreement float64                  `json:"avg_agreement"`
+	AvgTiming    float64                  `json:"avg_timing"`
+	Status       models.VoteClusterStatus `json:"status"`
+	StatusText   string                   `json:"status_text"`
+	Handler      *UserResponse            `json:"handler,omitempty"`
+	Discounted   int                      `json:"discounted"`
+	HandledAt    string                   `json:"handled_at,omitempty"`
+	CreatedAt    string                   `json:"created_at"`
+}
+
+// ToVoteClusterResponse 转换为投票圈响应
+func ToVoteClusterResponse(cluster *models.VoteCluster) *VoteClusterResponse {
+	resp := &VoteClusterResponse{
+		ID:           cluster.ID,
+		MemberIDs:    cluster.MemberIDs,
+		Pairs:        cluster.Pairs,
+		PostIDs:      cluster.PostIDs,
+		AvgAgreement: cluster.AvgAgreement,
+		AvgTiming:    cluster.AvgTiming,
+		Status:       cluster.Status,
+		StatusText:   getVoteClusterStatusText(cluster.Status),
+		Discounted:   cluster.Discounted,
+		CreatedAt:    cluster.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+
+	if cluster.Handler != nil {
+		resp.Handler = ToUserResponse(cluster.Handler)
+	}
+	if cluster.HandledAt != nil {
+		resp.HandledAt = cluster.HandledAt.Format("2006-01-02 15:04:05")
+	}
+
+	return resp
+}
+
+// ToVoteClusterResponseList 批量转换为投票圈响应列表
+func ToVoteClusterResponseList(clusters []*models.VoteCluster) []*VoteClusterResponse {
+	list := make([]*VoteClusterResponse, len(clusters))
+	for i, cluster := range clusters {
+		list[i] = ToVoteClusterResponse(cluster)
+	}
+	return list
+}
+
+// getVoteClusterStatusText 获取投票圈状态文本
+func getVoteClusterStatusText(status models.VoteClusterStatus) string {
+	switch status {
+	case models.VoteClusterOpen:
+		return "待处理"
+	case models.VoteClusterDiscounted:
+		return "已作废投票"
+	case models.VoteClusterDismissed:
+		return "已忽略"
+	default:
+		return "未知"
+	}
+}
+
+// CommentResponse 评论响应
+type CommentResponse struct {
+	ID          uint               `json:"id"`
+	PostID      uint               `json:"post_id"`
+	UserID      uint               `json:"user_id"`
+	User        *UserResponse      `json:"user,omitempty"`
+	ParentID    *uint              `json:"parent_id,omitempty"`
+	Content     string             `json:"content"`
+	IsApplicant bool               `json:"is_applicant"` // 是否为申请者本人的回复
+	Hidden      bool               `json:"hidden"`
+	Deleted     bool               `json:"deleted"`
+	EditedAt    string             `json:"edited_at,omitempty"`
+	CreatedAt   string             `json:"created_at"`
+	Replies     []*CommentResponse `json:"replies,omitempty"`
+}
+
+// ToCommentResponse 转换为评论响应
+// revealHidden 为 true 时(管理员)返回被隐藏评论的原始内容
+func ToCommentResponse(comment *models.Comment, revealHidden bool) *CommentResponse {
+	resp := &CommentResponse{
+		ID:          comment.ID,
+		PostID:      comment.PostID,
+		UserID:      comment.UserID,
+		ParentID:    comment.ParentID,
+		Content:     comment.Content,
+		IsApplicant: comment.IsApplicant,
+		Hidden:      comment.Hidden,
+		Deleted:     comment.Deleted,
+		CreatedAt:   comment.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+
+	if comment.EditedAt != nil {
+		resp.EditedAt = comment.EditedAt.Format("2006-01-02 15:04:05")
+	}
+
+	if comment.User != nil {
+		resp.User = ToUserResponse(comment.User)
+	}
+
+	switch {
+	case comment.Deleted:
+		resp.Content = "该评论已删除"
+	case comment.Hidden && !revealHidden:
+		resp.Content = "该评论已被管理员隐藏"
+	}
+
+	return resp
+}
+
+// BuildCommentTree 将顶层评论和回复组装为嵌套结构
+func BuildCommentTree(roots, replies []*models.Comment, revealHidden bool) []*CommentResponse {
+	nodes := make(map[uint]*CommentResponse, len(roots)+len(replies))
+	list := make([]*CommentResponse, len(roots))
+	for i, root := range roots {
+		list[i] = ToCommentResponse(root, revealHidden)
+		nodes[root.ID] = list[i]
+	}
+
+	// 回复按时间升序返回，父评论总是先于子评论出现
+	for _, reply := range replies {
+		node := ToCommentResponse(reply, revealHidden)
+		nodes[reply.ID] = node
+		if reply.ParentID == nil {
+			continue
+		}
+		if parent, ok := nodes[*reply.ParentID]; ok {
+			parent.Replies = append(parent.Replies, node)
+		}
+	}
+
+	return list
+}
+
 // VoteResponse 投票响应
 type VoteResponse struct {
 	PostID    uint            `json:"post_id"`
@@ -159,6 +704,96 @@ type VoteResponse struct {
 	UpVotes   int             `json:"up_votes"`
 	DownVotes int             `json:"down_votes"`
 	Message   string          `json:"message"`
+	// 投票是否计入票数，不满足投票资格要求时仅记录不计票
+	Counted          bool   `json:"counted"`
+	IneligibleReason string `json:"ineligible_reason,omitempty"`
+	// 盲投模式下票数是否被隐藏
+	TallyHidden bool `json:"tally_hidden,omitempty"`
+}
+
+// FormResponse 申请表响应
+type FormResponse struct {
+	Version   int                   `json:"version"`
+	Questions []models.FormQuestion `json:"questions"`
+	CreatedBy uint                  `json:"created_by,omitempty"`
+	CreatedAt string                `json:"created_at,omitempty"`
+}
+
+// ToFormResponse 转换为申请表响应(未配置申请表时返回版本0和空问题列表)
+func ToFormResponse(form *models.ApplicationForm) *FormResponse {
+	if form == nil {
+		return &FormResponse{Questions: []models.FormQuestion{}}
+	}
+	questions := form.Questions
+	if questions == nil {
+		questions = []models.FormQuestion{}
+	}
+	return &FormResponse{
+		Version:   form.Version,
+		Questions: questions,
+		CreatedBy: form.CreatedBy,
+		CreatedAt: form.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// VoteOptionsResponse 投票选项响应
+type VoteOptionsResponse struct {
+	DownvoteReasonRequired bool     `json:"downvote_reason_required"` // 反对票是否必须填写原因
+	DownvoteCategories     []string `json:"downvote_categories"`      // 可选的反对原因分类
+}
+
+// DownvoteReasonsResponse 反对原因汇总响应(不包含投票者信息)
+type DownvoteReasonsResponse struct {
+	PostID     uint                         `json:"post_id"`
+	DownVotes  int                          `json:"down_votes"`
+	Categories []models.DownvoteReasonCount `json:"categories"`
+	Reasons    []string                     `json:"reasons"` // 反对票中填写的原因说明
+}
+
+// TokenResponse 个人访问令牌响应
+type TokenResponse struct {
+	ID          uint     `json:"id"`
+	Name        string   `json:"name"`
+	TokenPrefix string   `json:"token_prefix"`
+	Scopes      []string `json:"scopes"`
+	ExpiresAt   string   `json:"expires_at,omitempty"`
+	LastUsedAt  string   `json:"last_used_at,omitempty"`
+	Expired     bool     `json:"expired"`
+	CreatedAt   string   `json:"created_at"`
+}
+
+// ToTokenResponse 转换为个人访问令牌响应
+func ToTokenResponse(token *models.APIToken) *TokenResponse {
+	resp := &TokenResponse{
+		ID:          token.ID,
+		Name:        token.Name,
+		TokenPrefix: token.TokenPrefix,
+		Scopes:      token.ScopeList(),
+		Expired:     token.IsExpired(),
+		CreatedAt:   token.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+	if token.ExpiresAt != nil {
+		resp.ExpiresAt = token.ExpiresAt.Format("2006-01-02 15:04:05")
+	}
+	if token.LastUsedAt != nil {
+		resp.LastUsedAt = token.LastUsedAt.Format("2006-01-02 15:04:05")
+	}
+	return resp
+}
+
+// ToTokenResponseList 批量转换为个人访问令牌响应列表
+func ToTokenResponseList(tokens []*models.APIToken) []*TokenResponse {
+	list := make([]*TokenResponse, len(tokens))
+	for i, token := range tokens {
+		list[i] = ToTokenResponse(token)
+	}
+	return list
+}
+
+// CreateTokenResponse 创建个人访问令牌响应(明文令牌只返回一次)
+type CreateTokenResponse struct {
+	*TokenResponse
+	Token string `json:"token"`
 }
 
 // ConfigResponse 配置响应
@@ -216,10 +851,12 @@ type StatsResponse struct {
 	CertifiedUsers    int64 `json:"certified_users"`
 	TotalPosts        int64 `json:"total_posts"`
 	PendingPosts      int64 `json:"pending_posts"`
+	HeldPosts         int64 `json:"held_posts"` // 待审核中被自动暂停的申请(不会自动放行)
 	FirstReviewPosts  int64 `json:"first_review_posts"`
 	SecondReviewPosts int64 `json:"second_review_posts"`
 	ApprovedPosts     int64 `json:"approved_posts"`
 	RejectedPosts     int64 `json:"rejected_posts"`
+	WithdrawnPosts    int64 `json:"withdrawn_posts"`
 	TotalVotes        int64 `json:"total_votes"`
 	TodayNewUsers     int64 `json:"today_new_users"`
 	TodayNewPosts     int64 `json:"today_new_posts"`
@@ -236,3 +873,213 @@ type OAuthURLResponse struct {
 type SystemStatusResponse struct {
 	Initialized bool `json:"initialized"` // 是否已初始化（是否有管理员）
 }
+
+// ContentRuleHit 命中的内容规则
+type ContentRuleHit struct {
+	RuleID  uint                     `json:"rule_id,omitempty"`
+	Name    string                   `json:"name"`
+	Action  models.ContentRuleAction `json:"action"`
+	Message string                   `json:"message,omitempty"`
+	Detail  string                   `json:"detail"` // 命中的具体内容
+}
+
+// ContentRuleTestResponse 内容规则试运行结果
+type ContentRuleTestResponse struct {
+	Action models.ContentRuleAction `json:"action,omitempty"` // 最终处理方式，为空表示未命中任何规则
+	Hits   []ContentRuleHit         `json:"hits"`
+}
+
+// ReviewDecisionResponse 审核员意见响应
+type ReviewDecisionResponse struct {
+	ID         uint                      `json:"id"`
+	ReviewerID uint                      `json:"reviewer_id"`
+	Reviewer   *UserResponse             `json:"reviewer,omitempty"`
+	Decision   models.ReviewDecisionType `json:"decision"`
+	Note       string                    `json:"note,omitempty"`
+	Closed     bool                      `json:"closed"` // 所在轮次的审核是否已结束
+	CreatedAt  string                    `json:"created_at"`
+}
+
+// ToReviewDecisionResponse 转换为审核员意见响应
+func ToReviewDecisionResponse(decision *models.ReviewDecision) *ReviewDecisionResponse {
+	resp := &ReviewDecisionResponse{
+		ID:         decision.ID,
+		ReviewerID: decision.ReviewerID,
+		Decision:   decision.Decision,
+		Note:       decision.Note,
+		Closed:     decision.Closed,
+		CreatedAt:  decision.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+	if decision.Reviewer != nil {
+		resp.Reviewer = ToUserResponse(decision.Reviewer)
+	}
+	return resp
+}
+
+// ReviewConsensusResponse 二级审核进度(多人审核模式)
+type ReviewConsensusResponse struct {
+	PostID            uint                      `json:"post_id"`
+	Status            models.PostStatus         `json:"status"`
+	Approvals         int                       `json:"approvals"`          // 本轮同意人数
+	Rejections        int                       `json:"rejections"`         // 本轮否决人数
+	RequiredApprovals int                       `json:"required_approvals"` // 通过所需的同意人数
+	VetoCount         int                       `json:"veto_count"`         // 拒绝所需的否决人数
+	Final             bool                      `json:"final"`              // 本次提交的意见是否结束了审核
+	Message           string                    `json:"message,omitempty"`
+	Decisions         []*ReviewDecisionResponse `json:"decisions"`
+}
+
+// ReviewerNoteResponse 审核员备注响应
+type ReviewerNoteResponse struct {
+	ID        uint          `json:"id"`
+	UserID    uint          `json:"user_id"`
+	User      *UserResponse `json:"user,omitempty"`
+	Content   string        `json:"content"`
+	CreatedAt string        `json:"created_at"`
+}
+
+// ToReviewerNoteResponse 转换为审核员备注响应
+func ToReviewerNoteResponse(note *models.ReviewerNote) *ReviewerNoteResponse {
+	resp := &ReviewerNoteResponse{
+		ID:        note.ID,
+		UserID:    note.UserID,
+		Content:   note.Content,
+		CreatedAt: note.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+	if note.User != nil {
+		resp.User = ToUserResponse(note.User)
+	}
+	return resp
+}
+
+// ToReviewerNoteResponseList 批量转换为审核员备注响应
+func ToReviewerNoteResponseList(notes []*models.ReviewerNote) []*ReviewerNoteResponse {
+	list := make([]*ReviewerNoteResponse, len(notes))
+	for i, note := range notes {
+		list[i] = ToReviewerNoteResponse(note)
+	}
+	return list
+}
+
+// ReviewLockResponse 审核锁定响应
+type ReviewLockResponse struct {
+	ID            uint          `json:"id"`
+	PostID        uint          `json:"post_id"`
+	PostTitle     string        `json:"post_title,omitempty"`
+	UserID        uint          `json:"user_id"`
+	User          *UserResponse `json:"user,omitempty"` // 锁定的审核员
+	LockedAt      string        `json:"locked_at"`      // 领取时间
+	AgeSeconds    int64         `json:"age_seconds,omitempty"`
+	HeartbeatAt   string        `json:"heartbeat_at,omitempty"` // 最近一次续期时间
+	ExpiresAt     string        `json:"expires_at,omitempty"`
+	ReleasedAt    string        `json:"released_at,omitempty"`
+	ReleaseReason string        `json:"release_reason,omitempty"` // skip/expiry/decision/admin/sla/withdraw
+	ReleasedBy    *uint         `json:"released_by,omitempty"`
+}
+
+// ToReviewLockResponse 转换为审核锁定响应
+func ToReviewLockResponse(lock *models.ReviewLock) *ReviewLockResponse {
+	resp := &ReviewLockResponse{
+		ID:            lock.ID,
+		PostID:        lock.PostID,
+		UserID:        lock.UserID,
+		LockedAt:      lock.LockedAt.Format("2006-01-02 15:04:05"),
+		ReleaseReason: lock.ReleaseReason,
+		ReleasedBy:    lock.ReleasedBy,
+	}
+	if lock.Post != nil {
+		resp.PostTitle = lock.Post.Title
+	}
+	if lock.User != nil {
+		resp.User = ToUserResponse(lock.User)
+	}
+	if lock.ReleasedAt != nil {
+		resp.ReleasedAt = lock.ReleasedAt.Format("2006-01-02 15:04:05")
+	}
+	return resp
+}
+
+// ReviewSkipResponse 审核员跳过记录响应
+type ReviewSkipResponse struct {
+	PostID    uint              `json:"post_id"`
+	PostTitle string            `json:"post_title,omitempty"`
+	Status    models.PostStatus `json:"status"`
+	Times     int               `json:"times"`
+	ExpiresAt string            `json:"expires_at,omitempty"` // 为空表示不过期
+	SkippedAt string            `json:"skipped_at"`           // 最近一次跳过的时间
+}
+
+// ToReviewSkipResponse 转换为审核员跳过记录响应
+func ToReviewSkipResponse(skip *models.ReviewSkip) *ReviewSkipResponse {
+	resp := &ReviewSkipResponse{
+		PostID:    skip.PostID,
+		Times:     skip.Times,
+		SkippedAt: skip.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+	if skip.Post != nil {
+		resp.PostTitle = skip.Post.Title
+		resp.Status = skip.Post.Status
+	}
+	if skip.ExpiresAt != nil {
+		resp.ExpiresAt = skip.ExpiresAt.Format("2006-01-02 15:04:05")
+	}
+	return resp
+}
+
+// SkippedPostResponse 被多名审核员跳过的申请
+type SkippedPostResponse struct {
+	Post      *PostResponse `json:"post"`
+	Reviewers int64         `json:"reviewers"` // 跳过的审核员人数(含已过期的跳过)
+	Skips     int64         `json:"skips"`     // 累计跳过次数
+}
+
+// ReviewPriority 申请在审核队列中的优先级及各项组成
+type ReviewPriority struct {
+	Score               float64 `json:"score"`
+	WaitHours           float64 `json:"wait_hours"`           // 在二级审核中等待的小时数
+	ApprovalRate        float64 `json:"approval_rate"`        // 赞率(百分比)
+	Votes               float64 `json:"votes"`                // 票数(开启信誉加权时为加权票数)
+	ApplicantReputation float64 `json:"applicant_reputation"` // 申请者的投票者信誉分
+	Boost               float64 `json:"boost"`                // 管理员设置的加成
+	Escalated           bool    `json:"escalated"`            // 是否超过审核时限被升级(升级的申请优先分配)
+}
+
+// UserRelationshipResponse 用户关系响应
+type UserRelationshipResponse struct {
+	ID            uint          `json:"id"`
+	User          *UserResponse `json:"user,omitempty"`
+	UserID        uint          `json:"user_id"`
+	RelatedUser   *UserResponse `json:"related_user,omitempty"`
+	RelatedUserID uint          `json:"related_user_id"`
+	Note          string        `json:"note,omitempty"`
+	CreatedBy     uint          `json:"created_by"`
+	CreatedAt     string        `json:"created_at"`
+}
+
+// ToUserRelationshipResponse 转换为用户关系响应
+func ToUserRelationshipResponse(rel *models.UserRelationship) *UserRelationshipResponse {
+	resp := &UserRelationshipResponse{
+		ID:            rel.ID,
+		UserID:        rel.UserID,
+		RelatedUserID: rel.RelatedUserID,
+		Note:          rel.Note,
+		CreatedBy:     rel.CreatedBy,
+		CreatedAt:     rel.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+	if rel.User != nil {
+		resp.User = ToUserResponse(rel.User)
+	}
+	if rel.RelatedUser != nil {
+		resp.RelatedUser = ToUserResponse(rel.RelatedUser)
+	}
+	return resp
+}
+
+// ToUserRelationshipResponseList 批量转换为用户关系响应列表
+func ToUserRelationshipResponseList(rels []*models.UserRelationship) []*UserRelationshipResponse {
+	list := make([]*UserRelationshipResponse, len(rels))
+	for i, rel := range rels {
+		list[i] = ToUserRelationshipResponse(rel)
+	}
+	return list
+}
@@ -4,6 +4,7 @@ import (
 	"strconv"
 
 	"linuxdo-review/dto"
+	"linuxdo-review/middleware"
 	"linuxdo-review/models"
 	"linuxdo-review/pkg/response"
 	"linuxdo-review/service"
@@ -14,12 +15,14 @@ import (
 // AdminHandler 管理后台处理器
 type AdminHandler struct {
 	adminService *service.AdminService
+	applyService *service.ApplyPolicyService
 }
 
 // NewAdminHandler 创建管理后台处理器
-func NewAdminHandler(adminService *service.AdminService) *AdminHandler {
+func NewAdminHandler(adminService *service.AdminService, applyService *service.ApplyPolicyService) *AdminHandler {
 	return &AdminHandler{
 		adminService: adminService,
+		applyService: applyService,
 	}
 }
 
@@ -88,6 +91,76 @@ func (h *AdminHandler) UpdateUserRole(c *gin.Context) {
 	response.SuccessMessage(c, "更新成功")
 }
 
+// GetUserApplyStatus 获取用户的申请资格状态(冷却期、申请次数、豁免)
+func (h *AdminHandler) GetUserApplyStatus(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的用户ID")
+		return
+	}
+
+	if _, err := h.adminService.GetUserByID(uint(id)); err != nil {
+		response.NotFound(c, "用户不存在")
+		return
+	}
+
+	status, err := h.applyService.GetStatusResponse(uint(id))
+	if err != nil {
+		response.Error(c, "获取申请资格状态失败")
+		return
+	}
+
+	response.Success(c, status)
+}
+
+// GrantExemption 授予用户申请限制豁免
+func (h *AdminHandler) GrantExemption(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的用户ID")
+		return
+	}
+
+	var req dto.GrantExemptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "参数错误: "+err.Error())
+		return
+	}
+
+	if _, err := h.adminService.GetUserByID(uint(id)); err != nil {
+		response.NotFound(c, "用户不存在")
+		return
+	}
+
+	adminID := middleware.GetUserID(c)
+	exemption, err := h.applyService.GrantExemption(adminID, uint(id), req.Reason, req.ExpiresInDays)
+	if err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.Success(c, exemption)
+}
+
+// RevokeExemption 撤销用户的申请限制豁免
+func (h *AdminHandler) RevokeExemption(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的用户ID")
+		return
+	}
+
+	if err := h.applyService.RevokeExemption(uint(id)); err != nil {
+		response.Error(c, "撤销豁免失败")
+		return
+	}
+
+	response.SuccessMessage(c, "已撤销豁免")
+}
+
 // GetConfigs 获取配置
 func (h *AdminHandler) GetConfigs(c *gin.Context) {
 	configs, err := h.adminService.GetConfigs()
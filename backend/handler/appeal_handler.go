@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"strconv"
+
+	"linuxdo-review/dto"
+	"linuxdo-review/middleware"
+	"linuxdo-review/models"
+	"linuxdo-review/pkg/response"
+	"linuxdo-review/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AppealHandler 申诉处理器
+type AppealHandler struct {
+	appealService *service.AppealService
+}
+
+// NewAppealHandler 创建申诉处理器
+func NewAppealHandler(appealService *service.AppealService) *AppealHandler {
+	return &AppealHandler{
+		appealService: appealService,
+	}
+}
+
+// Create 对被拒绝的申请提交申诉
+func (h *AppealHandler) Create(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的帖子ID")
+		return
+	}
+
+	var req dto.CreateAppealRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "参数错误: "+err.Error())
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+	appeal, err := h.appealService.Create(uint(id), userID, req.Statement)
+	if err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.Success(c, dto.ToAppealResponse(appeal))
+}
+
+// GetByPost 获取申请的申诉状态(申请者本人或认证用户)
+func (h *AppealHandler) GetByPost(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的帖子ID")
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+	appeal, err := h.appealService.GetByPostForUser(uint(id), userID, canModerate(c))
+	if err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.Success(c, dto.ToAppealResponse(appeal))
+}
+
+// List 获取申诉队列
+func (h *AppealHandler) List(c *gin.Context) {
+	var req dto.AppealListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, "参数错误")
+		return
+	}
+
+	appeals, total, err := h.appealService.List(req.Status, req.GetPage(), req.GetPageSize())
+	if err != nil {
+		response.Error(c, "获取申诉列表失败")
+		return
+	}
+
+	response.Success(c, dto.PaginationResponse{
+		List:     dto.ToAppealResponseList(appeals),
+		Total:    total,
+		Page:     req.GetPage(),
+		PageSize: req.GetPageSize(),
+	})
+}
+
+// Get 获取申诉详情(含内部备注)
+func (h *AppealHandler) Get(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的申诉ID")
+		return
+	}
+
+	appeal, notes, err := h.appealService.GetByID(uint(id))
+	if err != nil {
+		response.NotFound(c, err.Error())
+		return
+	}
+
+	resp := dto.ToAppealResponse(appeal)
+	resp.Notes = make([]*dto.AppealNoteResponse, len(notes))
+	for i, note := range notes {
+		resp.Notes[i] = dto.ToAppealNoteResponse(note)
+	}
+
+	response.Success(c, resp)
+}
+
+// Accept 接受申诉并重新开启申请
+func (h *AppealHandler) Accept(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的申诉ID")
+		return
+	}
+
+	var req dto.AcceptAppealRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "参数错误: "+err.Error())
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+	if err := h.appealService.Accept(uint(id), userID, models.PostStatus(req.TargetStatus), req.Note); err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.SuccessMessage(c, "申诉已接受，申请已重新开启")
+}
+
+// Deny 驳回申诉
+func (h *AppealHandler) Deny(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的申诉ID")
+		return
+	}
+
+	// 允许不提供处理说明(不带请求体)
+	var req dto.DenyAppealRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.BadRequest(c, "参数错误: "+err.Error())
+			return
+		}
+	}
+
+	userID := middleware.GetUserID(c)
+	if err := h.appealService.Deny(uint(id), userID, req.Note); err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.SuccessMessage(c, "申诉已驳回")
+}
+
+// AddNote 为申诉添加内部备注
+func (h *AppealHandler) AddNote(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的申诉ID")
+		return
+	}
+
+	var req dto.AppealNoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "参数错误: "+err.Error())
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+	note, err := h.appealService.AddNote(uint(id), userID, req.Content)
+	if err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.Success(c, dto.ToAppealNoteResponse(note))
+}
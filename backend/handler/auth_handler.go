@@ -12,15 +12,21 @@ import (
 
 // AuthHandler 认证处理器
 type AuthHandler struct {
-	authService *service.AuthService
-	cfg         *config.Config
+	authService  *service.AuthService
+	applyService *service.ApplyPolicyService
+	reputation   *service.ReputationService
+	conflicts    *service.ConflictService
+	cfg          *config.Config
 }
 
 // NewAuthHandler 创建认证处理器
-func NewAuthHandler(authService *service.AuthService, cfg *config.Config) *AuthHandler {
+func NewAuthHandler(authService *service.AuthService, applyService *service.ApplyPolicyService, reputation *service.ReputationService, conflicts *service.ConflictService, cfg *config.Config) *AuthHandler {
 	return &AuthHandler{
-		authService: authService,
-		cfg:         cfg,
+		authService:  authService,
+		applyService: applyService,
+		reputation:   reputation,
+		conflicts:    conflicts,
+		cfg:          cfg,
 	}
 }
 
@@ -37,6 +43,7 @@ func (h *AuthHandler) Register(c *gin.Context) {
 		response.Error(c, err.Error())
 		return
 	}
+	h.recordDevice(c, user.ID)
 
 	response.Success(c, dto.ToUserResponse(user))
 }
@@ -54,10 +61,16 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		response.Error(c, err.Error())
 		return
 	}
+	h.recordDevice(c, result.User.ID)
 
 	response.Success(c, result)
 }
 
+// recordDevice 记录登录使用的IP和设备指纹(用于审核利益冲突检测)
+func (h *AuthHandler) recordDevice(c *gin.Context, userID uint) {
+	h.conflicts.RecordDevice(userID, c.ClientIP(), c.GetHeader("X-Device-Fingerprint"))
+}
+
 // Me 获取当前用户信息
 func (h *AuthHandler) Me(c *gin.Context) {
 	userID := middleware.GetUserID(c)
@@ -72,7 +85,12 @@ func (h *AuthHandler) Me(c *gin.Context) {
 		return
 	}
 
-	response.Success(c, dto.ToUserResponse(user))
+	resp := &dto.MeResponse{UserResponse: dto.ToUserResponse(user)}
+	// 申请资格状态(冷却期等)获取失败不影响用户信息返回
+	resp.ApplyStatus, _ = h.applyService.GetStatusResponse(userID)
+	resp.Reputation, _ = h.reputation.Get(userID)
+
+	response.Success(c, resp)
 }
 
 // OAuthLinuxDo Linux.do OAuth跳转
@@ -154,6 +172,7 @@ func (h *AuthHandler) OAuthLinuxDoCallback(c *gin.Context) {
 		c.Redirect(302, profileURL)
 	} else {
 		// 登录模式：重定向到登录回调页面
+		h.recordDevice(c, result.LoginResponse.User.ID)
 		c.Redirect(302, frontendCallbackURL+"?token="+result.LoginResponse.Token)
 	}
 }
@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"strconv"
+
+	"linuxdo-review/dto"
+	"linuxdo-review/middleware"
+	"linuxdo-review/models"
+	"linuxdo-review/pkg/response"
+	"linuxdo-review/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CommentHandler 评论处理器
+type CommentHandler struct {
+	commentService *service.CommentService
+}
+
+// NewCommentHandler 创建评论处理器
+func NewCommentHandler(commentService *service.CommentService) *CommentHandler {
+	return &CommentHandler{
+		commentService: commentService,
+	}
+}
+
+// List 分页获取帖子的评论(按顶层评论分页，回复嵌套返回)
+func (h *CommentHandler) List(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的帖子ID")
+		return
+	}
+
+	var pagination dto.PaginationRequest
+	if err := c.ShouldBindQuery(&pagination); err != nil {
+		response.BadRequest(c, "参数错误")
+		return
+	}
+
+	roots, replies, total, err := h.commentService.List(uint(id), middleware.GetUserID(c), canModerate(c), pagination.GetPage(), pagination.GetPageSize())
+	if err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	isAdmin := middleware.GetUserRole(c) == int(models.RoleAdmin)
+	response.Success(c, dto.PaginationResponse{
+		List:     dto.BuildCommentTree(roots, replies, isAdmin),
+		Total:    total,
+		Page:     pagination.GetPage(),
+		PageSize: pagination.GetPageSize(),
+	})
+}
+
+// Create 发表评论或回复
+func (h *CommentHandler) Create(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的帖子ID")
+		return
+	}
+
+	var req dto.CreateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "参数错误: "+err.Error())
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+	comment, err := h.commentService.Create(uint(id), userID, req.ParentID, req.Content)
+	if err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.Success(c, dto.ToCommentResponse(comment, false))
+}
+
+// Update 修改评论
+func (h *CommentHandler) Update(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的评论ID")
+		return
+	}
+
+	var req dto.UpdateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "参数错误: "+err.Error())
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+	comment, err := h.commentService.Update(uint(id), userID, req.Content)
+	if err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.Success(c, dto.ToCommentResponse(comment, false))
+}
+
+// Delete 删除评论(作者本人或管理员)
+func (h *CommentHandler) Delete(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的评论ID")
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == int(models.RoleAdmin)
+	if err := h.commentService.Delete(uint(id), userID, isAdmin); err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.SuccessMessage(c, "评论已删除")
+}
+
+// SetHidden 隐藏或恢复评论(管理员)
+func (h *CommentHandler) SetHidden(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的评论ID")
+		return
+	}
+
+	var req dto.SetCommentHiddenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "参数错误: "+err.Error())
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+	if err := h.commentService.SetHidden(uint(id), userID, *req.Hidden); err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	if *req.Hidden {
+		response.SuccessMessage(c, "评论已隐藏")
+		return
+	}
+	response.SuccessMessage(c, "评论已恢复显示")
+}
+
+// SetLocked 锁定或解锁帖子评论区(管理员)
+func (h *CommentHandler) SetLocked(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的帖子ID")
+		return
+	}
+
+	var req dto.SetCommentsLockedRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "参数错误: "+err.Error())
+		return
+	}
+
+	if err := h.commentService.SetLocked(uint(id), *req.Locked); err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	if *req.Locked {
+		response.SuccessMessage(c, "评论区已锁定")
+		return
+	}
+	response.SuccessMessage(c, "评论区已解锁")
+}
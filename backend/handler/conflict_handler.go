@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"strconv"
+
+	"linuxdo-review/dto"
+	"linuxdo-review/middleware"
+	"linuxdo-review/pkg/response"
+	"linuxdo-review/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ConflictHandler 审核利益冲突处理器
+type ConflictHandler struct {
+	conflictService *service.ConflictService
+}
+
+// NewConflictHandler 创建审核利益冲突处理器
+func NewConflictHandler(conflictService *service.ConflictService) *ConflictHandler {
+	return &ConflictHandler{
+		conflictService: conflictService,
+	}
+}
+
+// ListRelationships 获取登记的用户关系(管理员)
+func (h *ConflictHandler) ListRelationships(c *gin.Context) {
+	var req dto.UserRelationshipListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, "参数错误")
+		return
+	}
+
+	rels, total, err := h.conflictService.ListRelationships(req.UserID, req.GetPage(), req.GetPageSize())
+	if err != nil {
+		response.Error(c, "获取用户关系失败")
+		return
+	}
+
+	response.Success(c, dto.PaginationResponse{
+		List:     dto.ToUserRelationshipResponseList(rels),
+		Total:    total,
+		Page:     req.GetPage(),
+		PageSize: req.GetPageSize(),
+	})
+}
+
+// CreateRelationship 登记用户关系(管理员)
+func (h *ConflictHandler) CreateRelationship(c *gin.Context) {
+	var req dto.UserRelationshipRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "参数错误: "+err.Error())
+		return
+	}
+
+	rel, err := h.conflictService.CreateRelationship(req.UserID, req.RelatedUserID, middleware.GetUserID(c), req.Note)
+	if err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.Success(c, dto.ToUserRelationshipResponse(rel))
+}
+
+// DeleteRelationship 删除用户关系(管理员)
+func (h *ConflictHandler) DeleteRelationship(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的关系ID")
+		return
+	}
+
+	if err := h.conflictService.DeleteRelationship(uint(id)); err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.SuccessMessage(c, "关系已删除")
+}
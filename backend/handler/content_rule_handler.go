@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"strconv"
+
+	"linuxdo-review/dto"
+	"linuxdo-review/middleware"
+	"linuxdo-review/pkg/response"
+	"linuxdo-review/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ContentRuleHandler 内容规则处理器
+type ContentRuleHandler struct {
+	ruleService *service.ContentRuleService
+}
+
+// NewContentRuleHandler 创建内容规则处理器
+func NewContentRuleHandler(ruleService *service.ContentRuleService) *ContentRuleHandler {
+	return &ContentRuleHandler{
+		ruleService: ruleService,
+	}
+}
+
+// List 获取所有内容规则(管理员)
+func (h *ContentRuleHandler) List(c *gin.Context) {
+	rules, err := h.ruleService.List()
+	if err != nil {
+		response.Error(c, "获取规则失败")
+		return
+	}
+
+	response.Success(c, rules)
+}
+
+// Create 创建内容规则(管理员)
+func (h *ContentRuleHandler) Create(c *gin.Context) {
+	var req dto.ContentRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "参数错误: "+err.Error())
+		return
+	}
+
+	rule, err := h.ruleService.Create(middleware.GetUserID(c), &req)
+	if err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.Success(c, rule)
+}
+
+// Update 更新内容规则(管理员)
+func (h *ContentRuleHandler) Update(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的规则ID")
+		return
+	}
+
+	var req dto.ContentRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "参数错误: "+err.Error())
+		return
+	}
+
+	rule, err := h.ruleService.Update(uint(id), &req)
+	if err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.Success(c, rule)
+}
+
+// Delete 删除内容规则(管理员)
+func (h *ContentRuleHandler) Delete(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的规则ID")
+		return
+	}
+
+	if err := h.ruleService.Delete(uint(id)); err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.SuccessMessage(c, "规则已删除")
+}
+
+// Test 使用文本试运行内容规则，不会创建申请(管理员)
+func (h *ContentRuleHandler) Test(c *gin.Context) {
+	var req dto.TestContentRulesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "参数错误: "+err.Error())
+		return
+	}
+
+	result, err := h.ruleService.Test(&req)
+	if err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.Success(c, result)
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"strconv"
+
+	"linuxdo-review/dto"
+	"linuxdo-review/middleware"
+	"linuxdo-review/pkg/response"
+	"linuxdo-review/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// FormHandler 申请表处理器
+type FormHandler struct {
+	formService *service.FormService
+}
+
+// NewFormHandler 创建申请表处理器
+func NewFormHandler(formService *service.FormService) *FormHandler {
+	return &FormHandler{
+		formService: formService,
+	}
+}
+
+// Current 获取当前生效的申请表
+func (h *FormHandler) Current(c *gin.Context) {
+	form, err := h.formService.GetCurrent()
+	if err != nil {
+		response.Error(c, "获取申请表失败")
+		return
+	}
+
+	response.Success(c, dto.ToFormResponse(form))
+}
+
+// ListVersions 获取所有申请表版本(管理员)
+func (h *FormHandler) ListVersions(c *gin.Context) {
+	forms, err := h.formService.ListVersions()
+	if err != nil {
+		response.Error(c, "获取申请表失败")
+		return
+	}
+
+	list := make([]*dto.FormResponse, len(forms))
+	for i, form := range forms {
+		list[i] = dto.ToFormResponse(form)
+	}
+
+	response.Success(c, list)
+}
+
+// GetVersion 获取指定版本的申请表(管理员)
+func (h *FormHandler) GetVersion(c *gin.Context) {
+	version, err := strconv.Atoi(c.Param("version"))
+	if err != nil || version <= 0 {
+		response.BadRequest(c, "无效的版本号")
+		return
+	}
+
+	form, err := h.formService.GetVersion(version)
+	if err != nil {
+		response.NotFound(c, err.Error())
+		return
+	}
+
+	response.Success(c, dto.ToFormResponse(form))
+}
+
+// Publish 发布新版本的申请表(管理员)
+func (h *FormHandler) Publish(c *gin.Context) {
+	var req dto.PublishFormRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "参数错误: "+err.Error())
+		return
+	}
+
+	adminID := middleware.GetUserID(c)
+	form, err := h.formService.Publish(adminID, req.Questions)
+	if err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.Success(c, dto.ToFormResponse(form))
+}
@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"strconv"
+
+	"linuxdo-review/dto"
+	"linuxdo-review/middleware"
+	"linuxdo-review/pkg/response"
+	"linuxdo-review/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ModerationHandler 预审处理器
+type ModerationHandler struct {
+	moderationService *service.ModerationService
+}
+
+// NewModerationHandler 创建预审处理器
+func NewModerationHandler(moderationService *service.ModerationService) *ModerationHandler {
+	return &ModerationHandler{
+		moderationService: moderationService,
+	}
+}
+
+// List 获取预审队列
+func (h *ModerationHandler) List(c *gin.Context) {
+	var pagination dto.PaginationRequest
+	if err := c.ShouldBindQuery(&pagination); err != nil {
+		response.BadRequest(c, "参数错误")
+		return
+	}
+
+	posts, total, err := h.moderationService.ListQueue(pagination.GetPage(), pagination.GetPageSize())
+	if err != nil {
+		response.Error(c, "获取预审队列失败")
+		return
+	}
+
+	list := dto.ToPostResponseList(posts)
+	for i, post := range posts {
+		list[i].ShowModerationInfo(post)
+	}
+
+	response.Success(c, dto.PaginationResponse{
+		List:     list,
+		Total:    total,
+		Page:     pagination.GetPage(),
+		PageSize: pagination.GetPageSize(),
+	})
+}
+
+// Release 放行到社区投票
+func (h *ModerationHandler) Release(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的帖子ID")
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+	if err := h.moderationService.Release(uint(id), userID); err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.SuccessMessage(c, "已放行到社区投票")
+}
+
+// Reject 预审拒绝
+func (h *ModerationHandler) Reject(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的帖子ID")
+		return
+	}
+
+	var req dto.RejectRequest
+	// 允许不提供拒绝原因
+	_ = c.ShouldBindJSON(&req)
+
+	userID := middleware.GetUserID(c)
+	if err := h.moderationService.Reject(uint(id), userID, req.Reason); err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.SuccessMessage(c, "已拒绝")
+}
@@ -41,10 +41,11 @@ func (h *PostHandler) Create(c *gin.Context) {
 		return
 	}
 
-	response.Success(c, dto.ToPostResponse(post))
+	response.Success(c, h.toPostResponse(c, post))
 }
 
 // List 获取帖子列表(申请列表)
+// 列表固定按创建时间排序，盲投模式下排序不会泄露票数
 func (h *PostHandler) List(c *gin.Context) {
 	var req dto.PostListRequest
 	if err := c.ShouldBindQuery(&req); err != nil {
@@ -52,7 +53,13 @@ func (h *PostHandler) List(c *gin.Context) {
 		return
 	}
 
-	// status 为 nil 时返回所有帖子
+	// 待审核的帖子只对管理员和认证用户公开
+	if req.Status != nil && *req.Status == int(models.StatusPending) && !canModerate(c) {
+		response.Forbidden(c, "需要 Linux.do 信任等级3及以上或管理员权限")
+		return
+	}
+
+	// status 为 nil 时返回除待审核外的所有帖子
 	// status 为具体值时返回对应状态的帖子
 	posts, total, err := h.postService.ListWithFilter(req.Status, req.GetPage(), req.GetPageSize())
 	if err != nil {
@@ -64,7 +71,7 @@ func (h *PostHandler) List(c *gin.Context) {
 	postResponses := make([]*dto.PostResponse, len(posts))
 	postIDs := make([]uint, len(posts))
 	for i, post := range posts {
-		postResponses[i] = dto.ToPostResponse(post)
+		postResponses[i] = h.toPostResponse(c, post)
 		postIDs[i] = post.ID
 	}
 
@@ -104,6 +111,7 @@ func (h *PostHandler) ListForReview(c *gin.Context) {
 	postResponses := make([]*dto.PostResponse, len(posts))
 	for i, post := range posts {
 		postResponses[i] = dto.ToPostResponse(post)
+		postResponses[i].ShowModerationInfo(post)
 	}
 
 	response.Success(c, dto.PaginationResponse{
@@ -129,18 +137,182 @@ func (h *PostHandler) Get(c *gin.Context) {
 		return
 	}
 
-	resp := dto.ToPostResponse(post)
+	if !canView(c, post) {
+		response.NotFound(c, "帖子不存在")
+		return
+	}
+
+	resp := h.toPostResponse(c, post)
+
+	// 疑似重复时附上相似的历史申请，供投票者和审核者对比
+	if post.PossibleDuplicate {
+		if matches, err := h.postService.ListSimilar(post.ID); err == nil {
+			resp.SimilarPosts = dto.ToSimilarPostResponseList(matches)
+		}
+	}
 
 	// 如果用户已登录,获取用户的投票情况
 	userID := middleware.GetUserID(c)
+
+	// 认证用户和管理员可以看到审核员内部备注(申请者本人除外)
+	if canModerate(c) && post.UserID != userID {
+		if notes, err := h.reviewService.ListNotes(post.ID, userID); err == nil {
+			resp.ReviewerNotes = dto.ToReviewerNoteResponseList(notes)
+		}
+	}
+
 	if userID > 0 {
-		vote, _ := h.postService.GetUserVoteForPost(uint(id), userID)
-		resp.MyVote = vote
+		if vote, _ := h.postService.GetUserVote(uint(id), userID); vote != nil {
+			resp.MyVote = int(vote.VoteType)
+			resp.MyVoteStale = vote.Stale
+		}
 	}
 
 	response.Success(c, resp)
 }
 
+// ListSimilar 获取帖子的所有相似匹配(认证用户)
+func (h *PostHandler) ListSimilar(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的帖子ID")
+		return
+	}
+
+	matches, err := h.postService.ListSimilar(uint(id))
+	if err != nil {
+		response.Error(c, "获取相似申请失败")
+		return
+	}
+
+	response.Success(c, dto.ToSimilarPostResponseList(matches))
+}
+
+// Update 修改帖子(仅申请者本人，投票阶段)
+func (h *PostHandler) Update(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的帖子ID")
+		return
+	}
+
+	var req dto.UpdatePostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "参数错误: "+err.Error())
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+	post, err := h.postService.Update(uint(id), userID, &req)
+	if err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.Success(c, h.toPostResponse(c, post))
+}
+
+// Withdraw 撤回申请(仅申请者本人)
+func (h *PostHandler) Withdraw(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的帖子ID")
+		return
+	}
+
+	// 允许不提供撤回原因(不带请求体)
+	var req dto.WithdrawRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.BadRequest(c, "参数错误: "+err.Error())
+			return
+		}
+	}
+
+	userID := middleware.GetUserID(c)
+	if err := h.postService.Withdraw(uint(id), userID, req.Reason); err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.SuccessMessage(c, "申请已撤回")
+}
+
+// ListEvents 获取帖子的历史记录
+func (h *PostHandler) ListEvents(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的帖子ID")
+		return
+	}
+
+	if !h.checkVisible(c, uint(id)) {
+		return
+	}
+
+	events, err := h.postService.ListEvents(uint(id))
+	if err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.Success(c, dto.ToPostEventResponseList(events))
+}
+
+// ListRevisions 获取帖子的修订记录
+func (h *PostHandler) ListRevisions(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的帖子ID")
+		return
+	}
+
+	if !h.checkVisible(c, uint(id)) {
+		return
+	}
+
+	revisions, err := h.postService.ListRevisions(uint(id))
+	if err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.Success(c, dto.ToRevisionResponseList(revisions))
+}
+
+// GetRevision 获取指定修订版本及与上一版本的差异
+func (h *PostHandler) GetRevision(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的帖子ID")
+		return
+	}
+
+	version, err := strconv.Atoi(c.Param("version"))
+	if err != nil || version < 1 {
+		response.BadRequest(c, "无效的版本号")
+		return
+	}
+
+	if !h.checkVisible(c, uint(id)) {
+		return
+	}
+
+	revision, previous, err := h.postService.GetRevisionWithPrevious(uint(id), version)
+	if err != nil {
+		response.NotFound(c, err.Error())
+		return
+	}
+
+	response.Success(c, dto.ToRevisionDiffResponse(revision, previous))
+}
+
 // Vote 投票
 func (h *PostHandler) Vote(c *gin.Context) {
 	idStr := c.Param("id")
@@ -157,7 +329,7 @@ func (h *PostHandler) Vote(c *gin.Context) {
 	}
 
 	userID := middleware.GetUserID(c)
-	voteResp, err := h.postService.VoteWithResponse(uint(id), userID, models.VoteType(req.VoteType))
+	voteResp, err := h.postService.VoteWithResponse(uint(id), userID, &req)
 	if err != nil {
 		response.Error(c, err.Error())
 		return
@@ -166,6 +338,41 @@ func (h *PostHandler) Vote(c *gin.Context) {
 	response.Success(c, voteResp)
 }
 
+// VoteOptions 获取投票选项(反对原因分类及是否必填)
+func (h *PostHandler) VoteOptions(c *gin.Context) {
+	response.Success(c, h.postService.GetVoteOptions())
+}
+
+// DownvoteReasons 获取帖子的反对原因汇总
+func (h *PostHandler) DownvoteReasons(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的帖子ID")
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+	reasons, err := h.postService.GetDownvoteReasons(uint(id), userID, canModerate(c), isAdmin(c))
+	if err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.Success(c, reasons)
+}
+
+// RenderContent 按当前配置重新渲染所有帖子内容(管理员)
+func (h *PostHandler) RenderContent(c *gin.Context) {
+	count, err := h.postService.RenderAllContent(false)
+	if err != nil {
+		response.Error(c, "重新渲染失败")
+		return
+	}
+
+	response.Success(c, gin.H{"rendered": count})
+}
+
 // MyPosts 获取我的帖子列表
 func (h *PostHandler) MyPosts(c *gin.Context) {
 	var pagination dto.PaginationRequest
@@ -183,7 +390,7 @@ func (h *PostHandler) MyPosts(c *gin.Context) {
 
 	postResponses := make([]*dto.PostResponse, len(posts))
 	for i, post := range posts {
-		postResponses[i] = dto.ToPostResponse(post)
+		postResponses[i] = h.toPostResponse(c, post)
 	}
 
 	response.Success(c, dto.PaginationResponse{
@@ -193,3 +400,41 @@ func (h *PostHandler) MyPosts(c *gin.Context) {
 		PageSize: pagination.GetPageSize(),
 	})
 }
+
+// toPostResponse 转换为帖子响应，盲投模式下对非管理员隐藏投票阶段的票数
+// 暂停原因和内容规则命中信息只返回给认证用户和管理员
+func (h *PostHandler) toPostResponse(c *gin.Context, post *models.Post) *dto.PostResponse {
+	resp := dto.ToPostResponse(post)
+	if h.postService.IsTallyHidden(post.Status, isAdmin(c)) {
+		resp.HideTally()
+	}
+	if canModerate(c) {
+		resp.ShowModerationInfo(post)
+	}
+	return resp
+}
+
+// canView 当前用户是否可以查看帖子: 待审核的帖子只有申请者本人、管理员和认证用户可以查看
+func canView(c *gin.Context, post *models.Post) bool {
+	return post.VisibleTo(middleware.GetUserID(c), canModerate(c))
+}
+
+// checkVisible 检查帖子是否存在且当前用户可以查看，不可查看时直接返回404
+func (h *PostHandler) checkVisible(c *gin.Context, postID uint) bool {
+	post, err := h.postService.GetByID(postID)
+	if err != nil || !canView(c, post) {
+		response.NotFound(c, "帖子不存在")
+		return false
+	}
+	return true
+}
+
+// isAdmin 当前用户是否为管理员
+func isAdmin(c *gin.Context) bool {
+	return middleware.GetUserRole(c) == int(models.RoleAdmin)
+}
+
+// canModerate 当前用户是否为管理员或认证用户(与 RequireCertified 中间件的判断一致)
+func canModerate(c *gin.Context) bool {
+	return middleware.GetUserRole(c) == int(models.RoleAdmin) || middleware.GetTrustLevel(c) >= 3
+}
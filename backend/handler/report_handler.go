@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+
+	"linuxdo-review/dto"
+	"linuxdo-review/middleware"
+	"linuxdo-review/pkg/response"
+	"linuxdo-review/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ReportHandler 举报处理器
+type ReportHandler struct {
+	reportService *service.ReportService
+}
+
+// NewReportHandler 创建举报处理器
+func NewReportHandler(reportService *service.ReportService) *ReportHandler {
+	return &ReportHandler{
+		reportService: reportService,
+	}
+}
+
+// Categories 获取举报分类
+func (h *ReportHandler) Categories(c *gin.Context) {
+	response.Success(c, h.reportService.GetCategories())
+}
+
+// Create 提交举报
+func (h *ReportHandler) Create(c *gin.Context) {
+	var req dto.CreateReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "参数错误: "+err.Error())
+		return
+	}
+
+	report, err := h.reportService.Create(middleware.GetUserID(c), &req)
+	if err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.Success(c, dto.ToReportResponse(report))
+}
+
+// List 获取举报队列(管理员)
+func (h *ReportHandler) List(c *gin.Context) {
+	var req dto.ReportListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, "参数错误")
+		return
+	}
+
+	reports, total, err := h.reportService.List(req.Status, req.TargetType, req.GetPage(), req.GetPageSize())
+	if err != nil {
+		response.Error(c, "获取举报列表失败")
+		return
+	}
+
+	response.Success(c, dto.PaginationResponse{
+		List:     dto.ToReportResponseList(reports),
+		Total:    total,
+		Page:     req.GetPage(),
+		PageSize: req.GetPageSize(),
+	})
+}
+
+// Resolve 确认举报属实(管理员)
+func (h *ReportHandler) Resolve(c *gin.Context) {
+	h.handle(c, true)
+}
+
+// Dismiss 驳回举报(管理员)
+func (h *ReportHandler) Dismiss(c *gin.Context) {
+	h.handle(c, false)
+}
+
+func (h *ReportHandler) handle(c *gin.Context, resolve bool) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的举报ID")
+		return
+	}
+
+	var req dto.HandleReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "参数错误: "+err.Error())
+		return
+	}
+
+	adminID := middleware.GetUserID(c)
+	var count int64
+	if resolve {
+		count, err = h.reportService.Resolve(uint(id), adminID, req.Note)
+	} else {
+		count, err = h.reportService.Dismiss(uint(id), adminID, req.Note)
+	}
+	if err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.SuccessMessage(c, fmt.Sprintf("已处理 %d 条举报", count))
+}
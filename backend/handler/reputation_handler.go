@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"strconv"
+
+	"linuxdo-review/pkg/response"
+	"linuxdo-review/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultLeaderboardSize = 20
+	maxLeaderboardSize     = 100
+)
+
+// ReputationHandler 投票者信誉处理器
+type ReputationHandler struct {
+	reputationService *service.ReputationService
+}
+
+// NewReputationHandler 创建投票者信誉处理器
+func NewReputationHandler(reputationService *service.ReputationService) *ReputationHandler {
+	return &ReputationHandler{
+		reputationService: reputationService,
+	}
+}
+
+// Leaderboard 获取投票者信誉排行榜
+func (h *ReputationHandler) Leaderboard(c *gin.Context) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(defaultLeaderboardSize)))
+	if err != nil || limit <= 0 {
+		limit = defaultLeaderboardSize
+	}
+	if limit > maxLeaderboardSize {
+		limit = maxLeaderboardSize
+	}
+
+	list, err := h.reputationService.Leaderboard(limit)
+	if err != nil {
+		response.Error(c, "获取排行榜失败")
+		return
+	}
+
+	response.Success(c, list)
+}
+
+// GetUser 获取用户的投票者信誉
+func (h *ReputationHandler) GetUser(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的用户ID")
+		return
+	}
+
+	rep, err := h.reputationService.Get(uint(id))
+	if err != nil {
+		response.Error(c, "获取信誉失败")
+		return
+	}
+
+	response.Success(c, rep)
+}
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"errors"
 	"strconv"
 	"strings"
 
@@ -10,6 +11,7 @@ import (
 	"linuxdo-review/service"
 
 	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
 )
 
 // ReviewHandler 审核处理器
@@ -42,13 +44,14 @@ func (h *ReviewHandler) Approve(c *gin.Context) {
 	}
 
 	reviewerID := middleware.GetUserID(c)
-	// 检查锁定状态并通过审核
-	if err := h.reviewService.CheckLockAndApprove(uint(id), reviewerID, req.InviteCode); err != nil {
+	// 检查锁定状态并提交同意意见(多人审核模式下达到人数要求才会通过)
+	result, err := h.reviewService.CheckLockAndApprove(uint(id), reviewerID, req.InviteCode, req.Note)
+	if err != nil {
 		response.Error(c, err.Error())
 		return
 	}
 
-	response.SuccessMessage(c, "审核通过，邀请码已发送给申请者")
+	response.Success(c, result)
 }
 
 // Reject 拒绝申请
@@ -65,20 +68,39 @@ func (h *ReviewHandler) Reject(c *gin.Context) {
 	_ = c.ShouldBindJSON(&req)
 
 	userID := middleware.GetUserID(c)
-	// 检查锁定状态并拒绝
-	if err := h.reviewService.CheckLockAndReject(uint(id), userID, req.Reason); err != nil {
+	// 检查锁定状态并提交否决意见(多人审核模式下达到否决人数才会拒绝)
+	result, err := h.reviewService.CheckLockAndReject(uint(id), userID, req.Reason, req.Note)
+	if err != nil {
 		response.Error(c, err.Error())
 		return
 	}
 
-	response.SuccessMessage(c, "已拒绝")
+	response.Success(c, result)
+}
+
+// Decisions 获取申请的二级审核进度及审核意见
+func (h *ReviewHandler) Decisions(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的帖子ID")
+		return
+	}
+
+	result, err := h.reviewService.GetConsensus(uint(id))
+	if err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.Success(c, result)
 }
 
 // GetNext 获取下一个待审核的帖子
 func (h *ReviewHandler) GetNext(c *gin.Context) {
 	userID := middleware.GetUserID(c)
 
-	// 获取要跳过的帖子ID列表（逗号分隔）
+	// 客户端额外指定要跳过的帖子ID列表（逗号分隔），已跳过的申请由服务端记录并自动排除
 	skipIDsStr := c.Query("skip_ids")
 	var skipIDs []uint
 	if skipIDsStr != "" {
@@ -89,8 +111,12 @@ func (h *ReviewHandler) GetNext(c *gin.Context) {
 		}
 	}
 
-	post, err := h.reviewService.GetNextForReview(userID, skipIDs)
+	post, priority, err := h.reviewService.GetNextForReview(userID, skipIDs)
 	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			response.Error(c, err.Error())
+			return
+		}
 		// 没有更多待审核的帖子
 		response.Success(c, gin.H{
 			"post":  nil,
@@ -102,12 +128,160 @@ func (h *ReviewHandler) GetNext(c *gin.Context) {
 	// 获取总数
 	total, _ := h.reviewService.GetReviewCount()
 
+	// 附上其他审核员留下的内部备注
+	notes, _ := h.reviewService.ListNotes(post.ID, userID)
+
 	response.Success(c, gin.H{
-		"post":  post,
-		"total": total,
+		"post":         post,
+		"priority":     priority, // 队列优先级及各项组成
+		"total":        total,
+		"notes":        dto.ToReviewerNoteResponseList(notes),
+		"lock_seconds": int(h.reviewService.LockTimeout().Seconds()), // 锁定时长，需在过期前发送心跳续期
+	})
+}
+
+// Heartbeat 续期当前持有的审核锁定
+func (h *ReviewHandler) Heartbeat(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的帖子ID")
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+	expiresAt, err := h.reviewService.Heartbeat(uint(id), userID)
+	if err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"expires_at": expiresAt.Format("2006-01-02 15:04:05")})
+}
+
+// ListLocks 获取当前有效的审核锁定(管理员)
+func (h *ReviewHandler) ListLocks(c *gin.Context) {
+	locks, err := h.reviewService.ListActiveLocks()
+	if err != nil {
+		response.Error(c, "获取锁定列表失败")
+		return
+	}
+
+	response.Success(c, locks)
+}
+
+// LockHistory 获取已释放的审核锁定记录(管理员)
+func (h *ReviewHandler) LockHistory(c *gin.Context) {
+	var pagination dto.PaginationRequest
+	if err := c.ShouldBindQuery(&pagination); err != nil {
+		response.BadRequest(c, "参数错误")
+		return
+	}
+
+	locks, total, err := h.reviewService.ListLockHistory(pagination.GetPage(), pagination.GetPageSize())
+	if err != nil {
+		response.Error(c, "获取锁定记录失败")
+		return
+	}
+
+	list := make([]*dto.ReviewLockResponse, len(locks))
+	for i, lock := range locks {
+		list[i] = dto.ToReviewLockResponse(lock)
+	}
+
+	response.Success(c, dto.PaginationResponse{
+		List:     list,
+		Total:    total,
+		Page:     pagination.GetPage(),
+		PageSize: pagination.GetPageSize(),
 	})
 }
 
+// SetPriority 设置申请在审核队列中的优先级加成(管理员)
+func (h *ReviewHandler) SetPriority(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的帖子ID")
+		return
+	}
+
+	var req dto.PriorityBoostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "参数错误: "+err.Error())
+		return
+	}
+
+	if err := h.reviewService.SetPriorityBoost(uint(id), *req.Boost); err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.SuccessMessage(c, "优先级已更新")
+}
+
+// ReleaseLock 强制释放申请的审核锁定(管理员)
+func (h *ReviewHandler) ReleaseLock(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的帖子ID")
+		return
+	}
+
+	adminID := middleware.GetUserID(c)
+	if err := h.reviewService.ForceRelease(uint(id), adminID); err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.SuccessMessage(c, "已释放锁定")
+}
+
+// ListNotes 获取申请的审核员内部备注
+func (h *ReviewHandler) ListNotes(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的帖子ID")
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+	notes, err := h.reviewService.ListNotes(uint(id), userID)
+	if err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.Success(c, dto.ToReviewerNoteResponseList(notes))
+}
+
+// AddNote 为申请添加审核员内部备注
+func (h *ReviewHandler) AddNote(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的帖子ID")
+		return
+	}
+
+	var req dto.ReviewerNoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "参数错误: "+err.Error())
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+	note, err := h.reviewService.AddNote(uint(id), userID, req.Content)
+	if err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.Success(c, dto.ToReviewerNoteResponse(note))
+}
+
 // Skip 跳过当前帖子
 func (h *ReviewHandler) Skip(c *gin.Context) {
 	idStr := c.Param("id")
@@ -117,11 +291,74 @@ func (h *ReviewHandler) Skip(c *gin.Context) {
 		return
 	}
 
+	var req dto.SkipReviewRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.BadRequest(c, "参数错误: "+err.Error())
+			return
+		}
+	}
+
 	userID := middleware.GetUserID(c)
-	if err := h.reviewService.SkipPost(uint(id), userID); err != nil {
+	if err := h.reviewService.SkipPost(uint(id), userID, req.ExpireHours); err != nil {
 		response.Error(c, err.Error())
 		return
 	}
 
 	response.SuccessMessage(c, "已跳过")
 }
+
+// ListSkips 获取我跳过的申请
+func (h *ReviewHandler) ListSkips(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	skips, err := h.reviewService.ListSkips(userID)
+	if err != nil {
+		response.Error(c, "获取跳过记录失败")
+		return
+	}
+
+	list := make([]*dto.ReviewSkipResponse, len(skips))
+	for i, skip := range skips {
+		list[i] = dto.ToReviewSkipResponse(skip)
+	}
+
+	response.Success(c, list)
+}
+
+// Unskip 取消跳过
+func (h *ReviewHandler) Unskip(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的帖子ID")
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+	if err := h.reviewService.Unskip(uint(id), userID); err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.SuccessMessage(c, "已取消跳过")
+}
+
+// MostSkipped 获取被多名审核员跳过的申请(管理员)
+func (h *ReviewHandler) MostSkipped(c *gin.Context) {
+	minReviewers, _ := strconv.Atoi(c.DefaultQuery("min_reviewers", "2"))
+	if minReviewers < 1 {
+		minReviewers = 1
+	}
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	list, err := h.reviewService.ListMostSkipped(minReviewers, limit)
+	if err != nil {
+		response.Error(c, "获取跳过统计失败")
+		return
+	}
+
+	response.Success(c, list)
+}
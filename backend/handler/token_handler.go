@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"strconv"
+
+	"linuxdo-review/dto"
+	"linuxdo-review/middleware"
+	"linuxdo-review/pkg/response"
+	"linuxdo-review/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TokenHandler 个人访问令牌处理器
+type TokenHandler struct {
+	tokenService *service.TokenService
+}
+
+// NewTokenHandler 创建个人访问令牌处理器
+func NewTokenHandler(tokenService *service.TokenService) *TokenHandler {
+	return &TokenHandler{
+		tokenService: tokenService,
+	}
+}
+
+// List 获取我的令牌列表
+func (h *TokenHandler) List(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	tokens, err := h.tokenService.List(userID)
+	if err != nil {
+		response.Error(c, "获取令牌列表失败")
+		return
+	}
+
+	response.Success(c, dto.ToTokenResponseList(tokens))
+}
+
+// Create 创建令牌
+func (h *TokenHandler) Create(c *gin.Context) {
+	var req dto.CreateTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "参数错误: "+err.Error())
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+	token, raw, err := h.tokenService.Create(userID, &req)
+	if err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.Success(c, &dto.CreateTokenResponse{
+		TokenResponse: dto.ToTokenResponse(token),
+		Token:         raw,
+	})
+}
+
+// Revoke 删除令牌
+func (h *TokenHandler) Revoke(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的令牌ID")
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+	if err := h.tokenService.Revoke(userID, uint(id)); err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.SuccessMessage(c, "令牌已删除")
+}
@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+
+	"linuxdo-review/dto"
+	"linuxdo-review/middleware"
+	"linuxdo-review/pkg/response"
+	"linuxdo-review/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// VoteRingHandler 投票圈处理器
+type VoteRingHandler struct {
+	voteRingService *service.VoteRingService
+}
+
+// NewVoteRingHandler 创建投票圈处理器
+func NewVoteRingHandler(voteRingService *service.VoteRingService) *VoteRingHandler {
+	return &VoteRingHandler{
+		voteRingService: voteRingService,
+	}
+}
+
+// List 获取投票圈报告(管理员)
+func (h *VoteRingHandler) List(c *gin.Context) {
+	var req dto.VoteClusterListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, "参数错误")
+		return
+	}
+
+	clusters, total, err := h.voteRingService.List(req.Status, req.GetPage(), req.GetPageSize())
+	if err != nil {
+		response.Error(c, "获取投票圈列表失败")
+		return
+	}
+
+	response.Success(c, dto.PaginationResponse{
+		List:     dto.ToVoteClusterResponseList(clusters),
+		Total:    total,
+		Page:     req.GetPage(),
+		PageSize: req.GetPageSize(),
+	})
+}
+
+// Get 获取投票圈详情(管理员)
+func (h *VoteRingHandler) Get(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的投票圈ID")
+		return
+	}
+
+	cluster, members, err := h.voteRingService.GetByID(uint(id))
+	if err != nil {
+		response.NotFound(c, err.Error())
+		return
+	}
+
+	resp := dto.ToVoteClusterResponse(cluster)
+	resp.Members = make([]*dto.UserResponse, len(members))
+	for i, member := range members {
+		resp.Members[i] = dto.ToUserResponse(member)
+	}
+
+	response.Success(c, resp)
+}
+
+// Analyze 立即执行投票圈分析(管理员)
+func (h *VoteRingHandler) Analyze(c *gin.Context) {
+	count, err := h.voteRingService.Analyze()
+	if err != nil {
+		response.Error(c, "投票圈分析失败")
+		return
+	}
+
+	response.SuccessMessage(c, fmt.Sprintf("分析完成，发现 %d 个待处理的投票圈", count))
+}
+
+// Discount 作废投票圈在相关申请上的投票(管理员)
+func (h *VoteRingHandler) Discount(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的投票圈ID")
+		return
+	}
+
+	count, err := h.voteRingService.Discount(uint(id), middleware.GetUserID(c))
+	if err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.SuccessMessage(c, fmt.Sprintf("已作废 %d 张投票", count))
+}
+
+// Dismiss 忽略投票圈(管理员)
+func (h *VoteRingHandler) Dismiss(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "无效的投票圈ID")
+		return
+	}
+
+	if err := h.voteRingService.Dismiss(uint(id), middleware.GetUserID(c)); err != nil {
+		response.Error(c, err.Error())
+		return
+	}
+
+	response.SuccessMessage(c, "已忽略")
+}
@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"time"
 
 	"linuxdo-review/config"
 	"linuxdo-review/database"
 	"linuxdo-review/handler"
+	"linuxdo-review/middleware"
 	"linuxdo-review/repository"
 	"linuxdo-review/router"
 	"linuxdo-review/service"
@@ -42,6 +44,23 @@ func main() {
 	postRepo := repository.NewPostRepository(db)
 	voteRepo := repository.NewVoteRepository(db)
 	configRepo := repository.NewConfigRepository(db)
+	tokenRepo := repository.NewTokenRepository(db)
+	revisionRepo := repository.NewRevisionRepository(db)
+	eventRepo := repository.NewPostEventRepository(db)
+	exemptionRepo := repository.NewExemptionRepository(db)
+	appealRepo := repository.NewAppealRepository(db)
+	commentRepo := repository.NewCommentRepository(db)
+	formRepo := repository.NewFormRepository(db)
+	similarityRepo := repository.NewSimilarityRepository(db)
+	contentRuleRepo := repository.NewContentRuleRepository(db)
+	reportRepo := repository.NewReportRepository(db)
+	voteClusterRepo := repository.NewVoteClusterRepository(db)
+	reputationRepo := repository.NewReputationRepository(db)
+	reviewDecisionRepo := repository.NewReviewDecisionRepository(db)
+	reviewerNoteRepo := repository.NewReviewerNoteRepository(db)
+	reviewLockRepo := repository.NewReviewLockRepository(db)
+	reviewSkipRepo := repository.NewReviewSkipRepository(db)
+	conflictRepo := repository.NewConflictRepository(db)
 
 	// 初始化默认配置
 	if err := configRepo.InitDefaults(); err != nil {
@@ -51,18 +70,69 @@ func main() {
 	// 初始化Service层
 	emailService := service.NewEmailService(cfg)
 	authService := service.NewAuthService(userRepo, cfg, emailService)
-	postService := service.NewPostService(postRepo, voteRepo, configRepo, userRepo, cfg)
-	reviewService := service.NewReviewService(postRepo, userRepo, configRepo, emailService)
+	applyPolicyService := service.NewApplyPolicyService(postRepo, configRepo, exemptionRepo)
+	formService := service.NewFormService(formRepo)
+	similarityService := service.NewSimilarityService(similarityRepo, postRepo, configRepo, eventRepo)
+	contentRuleService := service.NewContentRuleService(contentRuleRepo)
+	reportService := service.NewReportService(reportRepo, postRepo, commentRepo, userRepo, configRepo, eventRepo)
+	voteRingService := service.NewVoteRingService(voteClusterRepo, voteRepo, postRepo, userRepo, configRepo)
+	reputationService := service.NewReputationService(reputationRepo, voteRepo, configRepo)
+	conflictService := service.NewConflictService(conflictRepo, voteRepo, userRepo, configRepo)
+	postService := service.NewPostService(postRepo, voteRepo, configRepo, userRepo, revisionRepo, eventRepo, applyPolicyService, formService, similarityService, contentRuleService, reputationService, reviewDecisionRepo, reviewLockRepo, voteClusterRepo, cfg)
+	reviewService := service.NewReviewService(postRepo, userRepo, configRepo, eventRepo, emailService, reputationService, reviewDecisionRepo, reviewerNoteRepo, reviewLockRepo, reviewSkipRepo, conflictService, voteRepo)
 	adminService := service.NewAdminService(userRepo, postRepo, voteRepo, configRepo)
+	tokenService := service.NewTokenService(tokenRepo, userRepo, authService)
+	appealService := service.NewAppealService(appealRepo, postRepo, voteRepo, eventRepo, emailService)
+	commentService := service.NewCommentService(commentRepo, postRepo, userRepo, configRepo)
+	moderationService := service.NewModerationService(postRepo, configRepo, eventRepo, reviewService)
+
+	// 渲染尚未生成安全 HTML 的帖子内容
+	if count, err := postService.RenderAllContent(true); err != nil {
+		log.Printf("渲染帖子内容失败: %v", err)
+	} else if count > 0 {
+		log.Printf("已渲染 %d 个帖子的内容", count)
+	}
+
+	// 为历史帖子补充重复检测签名
+	if count, err := similarityService.BackfillSignatures(); err != nil {
+		log.Printf("补充帖子签名失败: %v", err)
+	} else if count > 0 {
+		log.Printf("已为 %d 个帖子补充签名", count)
+	}
+
+	// 定时自动放行超时的预审申请
+	moderationService.StartAutoRelease(10 * time.Minute)
+
+	// 每天分析一次投票圈
+	voteRingService.StartAnalysis(24 * time.Hour)
+
+	// 定时释放超时未续期的审核锁定
+	reviewService.StartLockExpiry(time.Minute)
+
+	// 定时检查二级审核时限(升级及兜底处理)
+	reviewService.StartSLACheck(10 * time.Minute)
+
+	// 启用个人访问令牌认证
+	middleware.SetTokenAuthenticator(tokenService)
 
 	// 初始化Handler层
-	authHandler := handler.NewAuthHandler(authService, cfg)
+	authHandler := handler.NewAuthHandler(authService, applyPolicyService, reputationService, conflictService, cfg)
 	postHandler := handler.NewPostHandler(postService, reviewService)
 	reviewHandler := handler.NewReviewHandler(reviewService, postService)
-	adminHandler := handler.NewAdminHandler(adminService)
+	adminHandler := handler.NewAdminHandler(adminService, applyPolicyService)
+	tokenHandler := handler.NewTokenHandler(tokenService)
+	appealHandler := handler.NewAppealHandler(appealService)
+	commentHandler := handler.NewCommentHandler(commentService)
+	formHandler := handler.NewFormHandler(formService)
+	moderationHandler := handler.NewModerationHandler(moderationService)
+	contentRuleHandler := handler.NewContentRuleHandler(contentRuleService)
+	reportHandler := handler.NewReportHandler(reportService)
+	voteRingHandler := handler.NewVoteRingHandler(voteRingService)
+	reputationHandler := handler.NewReputationHandler(reputationService)
+	conflictHandler := handler.NewConflictHandler(conflictService)
 
 	// 设置路由
-	r := router.SetupRouter(cfg, authHandler, postHandler, reviewHandler, adminHandler)
+	r := router.SetupRouter(cfg, authHandler, postHandler, reviewHandler, adminHandler, tokenHandler, appealHandler, commentHandler, formHandler, moderationHandler, contentRuleHandler, reportHandler, voteRingHandler, reputationHandler, conflictHandler)
 
 	// 启动服务器
 	addr := fmt.Sprintf(":%d", cfg.Server.Port)
@@ -1,9 +1,12 @@
 package middleware
 
 import (
+	"errors"
+	"net/http"
 	"strings"
 
 	"linuxdo-review/config"
+	"linuxdo-review/models"
 	"linuxdo-review/pkg/jwt"
 	"linuxdo-review/pkg/response"
 
@@ -23,10 +26,25 @@ const (
 	ContextTrustLevelKey = "trust_level"
 	// ContextLinuxDoIDKey 上下文中LinuxDo ID的key
 	ContextLinuxDoIDKey = "linuxdo_id"
+	// ContextTokenScopesKey 上下文中个人访问令牌权限范围的key(仅令牌认证时存在)
+	ContextTokenScopesKey = "token_scopes"
 )
 
+// TokenAuthenticator 个人访问令牌校验器
+type TokenAuthenticator interface {
+	AuthenticateToken(raw string) (*models.User, []string, error)
+}
+
+var tokenAuthenticator TokenAuthenticator
+
+// SetTokenAuthenticator 设置个人访问令牌校验器(未设置时不接受个人访问令牌)
+func SetTokenAuthenticator(a TokenAuthenticator) {
+	tokenAuthenticator = a
+}
+
 // JWTAuth JWT认证中间件
-func JWTAuth(cfg *config.Config) gin.HandlerFunc {
+// scopes 为该路由接受的个人访问令牌权限范围，为空表示只接受登录JWT
+func JWTAuth(cfg *config.Config, scopes ...string) gin.HandlerFunc {
 	jwtManager := jwt.NewJWTManager(cfg.JWT.Secret, cfg.JWT.ExpireHours)
 
 	return func(c *gin.Context) {
@@ -45,6 +63,21 @@ func JWTAuth(cfg *config.Config) gin.HandlerFunc {
 			return
 		}
 
+		// 个人访问令牌
+		if strings.HasPrefix(parts[1], models.APITokenPrefix) {
+			if err := authenticateAPIToken(c, parts[1], scopes); err != nil {
+				if errors.Is(err, errTokenScopeDenied) {
+					response.Forbidden(c, err.Error())
+				} else {
+					response.Unauthorized(c, err.Error())
+				}
+				c.Abort()
+				return
+			}
+			c.Next()
+			return
+		}
+
 		claims, err := jwtManager.ParseToken(parts[1])
 		if err != nil {
 			response.Unauthorized(c, err.Error())
@@ -65,7 +98,8 @@ func JWTAuth(cfg *config.Config) gin.HandlerFunc {
 }
 
 // OptionalJWTAuth 可选的JWT认证中间件(不强制要求登录)
-func OptionalJWTAuth(cfg *config.Config) gin.HandlerFunc {
+// scopes 为该路由接受的个人访问令牌权限范围，令牌无效或权限不足时按未登录处理
+func OptionalJWTAuth(cfg *config.Config, scopes ...string) gin.HandlerFunc {
 	jwtManager := jwt.NewJWTManager(cfg.JWT.Secret, cfg.JWT.ExpireHours)
 
 	return func(c *gin.Context) {
@@ -76,7 +110,9 @@ func OptionalJWTAuth(cfg *config.Config) gin.HandlerFunc {
 		}
 
 		parts := strings.SplitN(authHeader, " ", 2)
-		if len(parts) == 2 && parts[0] == "Bearer" {
+		if len(parts) == 2 && parts[0] == "Bearer" && strings.HasPrefix(parts[1], models.APITokenPrefix) {
+			_ = authenticateAPIToken(c, parts[1], scopes)
+		} else if len(parts) == 2 && parts[0] == "Bearer" {
 			claims, err := jwtManager.ParseToken(parts[1])
 			if err == nil {
 				c.Set(ContextUserIDKey, claims.UserID)
@@ -92,6 +128,58 @@ func OptionalJWTAuth(cfg *config.Config) gin.HandlerFunc {
 	}
 }
 
+var (
+	errTokenUnsupported = errors.New("个人访问令牌未启用")
+	errTokenScopeDenied = errors.New("该令牌没有访问此接口的权限")
+)
+
+// authenticateAPIToken 校验个人访问令牌并将用户信息存入上下文
+func authenticateAPIToken(c *gin.Context, raw string, accepted []string) error {
+	if tokenAuthenticator == nil {
+		return errTokenUnsupported
+	}
+
+	user, scopes, err := tokenAuthenticator.AuthenticateToken(raw)
+	if err != nil {
+		return err
+	}
+
+	if !tokenScopeAllowed(scopes, accepted, c.Request.Method) {
+		return errTokenScopeDenied
+	}
+
+	c.Set(ContextUserIDKey, user.ID)
+	c.Set(ContextUserEmailKey, user.Email)
+	c.Set(ContextUsernameKey, user.Username)
+	c.Set(ContextUserRoleKey, int(user.Role))
+	c.Set(ContextTrustLevelKey, user.TrustLevel)
+	c.Set(ContextLinuxDoIDKey, user.LinuxDoID)
+	c.Set(ContextTokenScopesKey, scopes)
+	return nil
+}
+
+// tokenScopeAllowed 检查令牌是否拥有路由接受的权限范围(只读权限只允许GET请求)
+func tokenScopeAllowed(tokenScopes, accepted []string, method string) bool {
+	for _, scope := range tokenScopes {
+		for _, a := range accepted {
+			if scope != a {
+				continue
+			}
+			if models.IsReadOnlyScope(scope) && method != http.MethodGet {
+				continue
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// IsTokenAuth 当前请求是否通过个人访问令牌认证
+func IsTokenAuth(c *gin.Context) bool {
+	_, exists := c.Get(ContextTokenScopesKey)
+	return exists
+}
+
 // GetUserID 从上下文获取用户ID
 func GetUserID(c *gin.Context) uint {
 	userID, exists := c.Get(ContextUserIDKey)
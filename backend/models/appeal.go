@@ -0,0 +1,57 @@
+package models
+
+import (
+	"time"
+)
+
+// AppealStatus 申诉状态
+type AppealStatus int
+
+const (
+	AppealPending  AppealStatus = 0 // 待处理
+	AppealAccepted AppealStatus = 1 // 已接受(申请已重新开启)
+	AppealDenied   AppealStatus = 2 // 已驳回
+)
+
+// Appeal 申诉模型(每个被拒绝的申请只能申诉一次)
+type Appeal struct {
+	ID           uint         `gorm:"primaryKey" json:"id"`
+	PostID       uint         `gorm:"uniqueIndex" json:"post_id"`
+	Post         *Post        `gorm:"foreignKey:PostID" json:"post,omitempty"`
+	UserID       uint         `gorm:"index" json:"user_id"`
+	User         *User        `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	Statement    string       `gorm:"type:text" json:"statement"` // 申诉陈述
+	Status       AppealStatus `gorm:"default:0;index" json:"status"`
+	HandlerID    *uint        `gorm:"index" json:"handler_id,omitempty"` // 处理人ID
+	Handler      *User        `gorm:"foreignKey:HandlerID" json:"handler,omitempty"`
+	DecisionNote string       `gorm:"size:500" json:"decision_note,omitempty"` // 处理说明(会发送给申请者)
+	ReopenedTo   *PostStatus  `json:"reopened_to,omitempty"`                   // 接受后申请重新开启到的状态
+	DecidedAt    *time.Time   `json:"decided_at,omitempty"`
+	CreatedAt    time.Time    `json:"created_at"`
+	UpdatedAt    time.Time    `json:"updated_at"`
+}
+
+// TableName 指定表名
+func (Appeal) TableName() string {
+	return "appeals"
+}
+
+// IsPending 是否待处理
+func (a *Appeal) IsPending() bool {
+	return a.Status == AppealPending
+}
+
+// AppealNote 申诉内部备注(仅处理人员可见)
+type AppealNote struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	AppealID  uint      `gorm:"index" json:"appeal_id"`
+	UserID    uint      `json:"user_id"`
+	User      *User     `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	Content   string    `gorm:"type:text" json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName 指定表名
+func (AppealNote) TableName() string {
+	return "appeal_notes"
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+)
+
+// Comment 申请评论模型(支持嵌套回复)
+type Comment struct {
+	ID          uint       `gorm:"primaryKey" json:"id"`
+	PostID      uint       `gorm:"index" json:"post_id"`
+	UserID      uint       `gorm:"index" json:"user_id"`
+	User        *User      `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	ParentID    *uint      `gorm:"index" json:"parent_id,omitempty"` // 回复的评论ID，为空表示顶层评论
+	RootID      *uint      `gorm:"index" json:"root_id,omitempty"`   // 所属顶层评论ID，用于按楼层分页
+	Content     string     `gorm:"type:text" json:"content"`
+	IsApplicant bool       `gorm:"default:false" json:"is_applicant"` // 是否为申请者本人的回复
+	Hidden      bool       `gorm:"default:false" json:"hidden"`       // 是否被管理员隐藏
+	HiddenBy    *uint      `json:"hidden_by,omitempty"`
+	Deleted     bool       `gorm:"default:false" json:"deleted"` // 已删除的评论保留占位以维持楼层结构
+	DeletedBy   *uint      `json:"deleted_by,omitempty"`
+	EditedAt    *time.Time `json:"edited_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// TableName 指定表名
+func (Comment) TableName() string {
+	return "comments"
+}
+
+// IsVisible 是否对普通用户可见
+func (c *Comment) IsVisible() bool {
+	return !c.Hidden && !c.Deleted
+}
+
+// WithinWindow 是否仍在发布后的时间窗口内(窗口为0表示不允许)
+func (c *Comment) WithinWindow(minutes int) bool {
+	if minutes <= 0 {
+		return false
+	}
+	return time.Since(c.CreatedAt) <= time.Duration(minutes)*time.Minute
+}
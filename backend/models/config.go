@@ -16,6 +16,67 @@ const (
 	ConfigLinuxDoRedirectURI  = "linuxdo_redirect_uri"  // OAuth回调地址
 	ConfigSiteName       = "site_name"        // 站点名称
 	ConfigSiteURL        = "site_url"         // 站点URL
+	ConfigEditVotePolicy      = "edit_vote_policy"      // 实质性修改申请后对已有投票的处理方式
+	ConfigEditSubstantialRate = "edit_substantial_rate" // 判定为实质性修改的内容变化比例(百分比)
+	ConfigReapplyCooldownHours      = "reapply_cooldown_hours"      // 被拒绝后重新申请的基础冷却时间(小时)
+	ConfigReapplyCooldownMultiplier = "reapply_cooldown_multiplier" // 每多一次拒绝冷却时间的倍增系数
+	ConfigReapplyCooldownMaxHours   = "reapply_cooldown_max_hours"  // 冷却时间上限(小时)
+	ConfigMaxApplyAttempts          = "max_apply_attempts"          // 终身最多申请次数(0表示不限制)
+	ConfigCommentEditMinutes   = "comment_edit_minutes"   // 评论发布后允许修改的时间窗口(分钟)
+	ConfigCommentDeleteMinutes = "comment_delete_minutes" // 评论发布后允许删除的时间窗口(分钟)
+	ConfigDownvoteReasonRequired   = "downvote_reason_required"   // 投反对票时是否必须填写原因
+	ConfigDownvoteReasonCategories = "downvote_reason_categories" // 反对原因分类(逗号分隔)
+	ConfigMarkdownNofollowLinks     = "markdown_nofollow_links"     // 渲染申请内容时为链接添加 rel="nofollow"
+	ConfigMarkdownImageProxyEnabled = "markdown_image_proxy_enabled" // 是否通过代理加载申请内容中的图片
+	ConfigMarkdownImageProxyURL     = "markdown_image_proxy_url"     // 图片代理地址前缀
+	ConfigSimilarityWarnThreshold = "similarity_warn_threshold" // 显示"疑似重复"警告的相似度阈值(百分比)
+	ConfigSimilarityHoldThreshold = "similarity_hold_threshold" // 自动暂停投票等待管理员处理的相似度阈值(百分比，0表示不启用)
+	ConfigModerationEnabled          = "moderation_enabled"            // 新申请是否需要先经过预审再进入投票
+	ConfigModerationAutoReleaseHours = "moderation_auto_release_hours" // 预审超过该时间自动放行(小时，0表示不自动放行)
+	ConfigReportCategories        = "report_categories"         // 举报分类(逗号分隔)
+	ConfigReportAutoHideThreshold = "report_auto_hide_threshold" // 被多少名可信用户举报后自动隐藏申请(0表示不启用)
+	ConfigReportTrustedLevel      = "report_trusted_level"       // 举报计入自动隐藏所需的最低LinuxDo信任等级
+	ConfigVoteMinTrustLevel     = "vote_min_trust_level"      // 投票计票所需的最低LinuxDo信任等级
+	ConfigVoteMinAccountDays    = "vote_min_account_days"     // 投票计票所需的最短注册天数
+	ConfigVoteMinPriorVotes     = "vote_min_prior_votes"      // 在其他申请上至少投过多少票后投票才计票
+	ConfigVoteRingMinShared     = "vote_ring_min_shared"      // 投票圈分析: 两人至少共同投过多少个申请
+	ConfigVoteRingMinAgreement  = "vote_ring_min_agreement"   // 投票圈分析: 投票一致率阈值(百分比)
+	ConfigVoteRingWindowSeconds = "vote_ring_window_seconds"  // 投票圈分析: 间隔不超过该秒数视为同时投票
+	ConfigVoteRingMinTimingRate = "vote_ring_min_timing_rate" // 投票圈分析: 同时投票比例阈值(百分比)
+	ConfigReputationHalfLifeDays = "reputation_half_life_days" // 投票者信誉的半衰期(天)
+	ConfigReputationVoteWeight   = "reputation_vote_weight"    // 是否按投票者信誉加权计算赞率
+	ConfigVoteMinReputation      = "vote_min_reputation"       // 投票计票所需的最低信誉(为空表示不限制)
+	ConfigBlindVoting = "blind_voting" // 投票阶段是否对非管理员隐藏票数
+	ConfigReviewRequiredApprovals = "review_required_approvals" // 二级审核通过所需的审核员同意人数
+	ConfigReviewVetoCount         = "review_veto_count"         // 二级审核中多少名审核员否决即拒绝申请
+	ConfigReviewLockMinutes = "review_lock_minutes" // 审核员领取申请后的锁定时长(分钟)，期间可通过心跳续期
+	ConfigReviewSkipHours   = "review_skip_hours"   // 审核员跳过的申请多久后重新出现在其队列中(小时，0表示不过期)
+	ConfigReviewPriorityWaitWeight       = "review_priority_wait_weight"       // 审核队列优先级: 每等待1小时的分数
+	ConfigReviewPriorityApprovalWeight   = "review_priority_approval_weight"   // 审核队列优先级: 赞率每1%的分数
+	ConfigReviewPriorityVotesWeight      = "review_priority_votes_weight"      // 审核队列优先级: 每张(按信誉加权的)投票的分数
+	ConfigReviewPriorityReputationWeight = "review_priority_reputation_weight" // 审核队列优先级: 申请者信誉每1分的分数
+	ConfigReviewConflictVoted        = "review_conflict_voted"         // 审核员投过票的申请视为利益冲突
+	ConfigReviewConflictRelationship = "review_conflict_relationship"  // 审核员与申请者有登记关系时视为利益冲突
+	ConfigReviewConflictSharedDevice = "review_conflict_shared_device" // 审核员与申请者近期使用过相同设备时视为利益冲突
+	ConfigReviewConflictDeviceDays    = "review_conflict_device_days"     // 相同设备判定的时间范围(天)
+	ConfigReviewConflictSharedIP      = "review_conflict_shared_ip"       // 近期使用过相同IP也视为相同设备
+	ConfigReviewSLAEscalateHours  = "review_sla_escalate_hours"  // 二级审核超过该时间未处理时升级并通知管理员(小时，0表示不启用)
+	ConfigReviewSLAFallbackHours  = "review_sla_fallback_hours"  // 二级审核超过该时间仍未处理时执行兜底操作(小时，0表示不启用)
+	ConfigReviewSLAFallbackAction = "review_sla_fallback_action" // 超过兜底时限后的处理方式
+)
+
+// 修改申请后对已有投票的处理方式
+const (
+	EditVotePolicyNone  = "none"  // 不处理
+	EditVotePolicyFlag  = "flag"  // 标记已有投票为修改前的投票
+	EditVotePolicyReset = "reset" // 清空已有投票重新计票
+)
+
+// 二级审核超过兜底时限后的处理方式
+const (
+	SLAFallbackNotify = "notify_certified" // 邮件通知所有认证用户
+	SLAFallbackReturn = "return_to_voting" // 退回社区投票
+	SLAFallbackWait   = "wait"             // 继续等待
 )
 
 // 默认配置值
@@ -24,6 +85,51 @@ const (
 	DefaultApprovalRate = 70
 	DefaultSMTPPort     = 587
 	DefaultSiteName     = "LinuxDo邀请码申请系统"
+	DefaultEditVotePolicy      = EditVotePolicyFlag
+	DefaultEditSubstantialRate = 30
+	DefaultReapplyCooldownHours      = 24
+	DefaultReapplyCooldownMultiplier = 2
+	DefaultReapplyCooldownMaxHours   = 720
+	DefaultMaxApplyAttempts          = 0
+	DefaultCommentEditMinutes   = 15
+	DefaultCommentDeleteMinutes = 60
+	DefaultDownvoteReasonRequired   = "false"
+	DefaultDownvoteReasonCategories = "内容过于简短,缺乏诚意,信息不实,疑似模板或抄袭,其他"
+	DefaultMarkdownNofollowLinks     = "false"
+	DefaultMarkdownImageProxyEnabled = "false"
+	DefaultSimilarityWarnThreshold = 60
+	DefaultSimilarityHoldThreshold = 0
+	DefaultModerationEnabled          = "false"
+	DefaultModerationAutoReleaseHours = 24
+	DefaultReportCategories        = "辱骂或人身攻击,泄露个人信息,买卖邀请码,垃圾广告,其他"
+	DefaultReportAutoHideThreshold = 0
+	DefaultReportTrustedLevel      = 2
+	DefaultVoteMinTrustLevel  = 0
+	DefaultVoteMinAccountDays = 0
+	DefaultVoteMinPriorVotes  = 0
+	DefaultVoteRingMinShared     = 5
+	DefaultVoteRingMinAgreement  = 90
+	DefaultVoteRingWindowSeconds = 120
+	DefaultVoteRingMinTimingRate = 50
+	DefaultReputationHalfLifeDays = 90
+	DefaultReputationVoteWeight   = "false"
+	DefaultBlindVoting = "false"
+	DefaultReviewRequiredApprovals = 1
+	DefaultReviewVetoCount         = 1
+	DefaultReviewLockMinutes = 5
+	DefaultReviewSkipHours   = 0
+	DefaultReviewPriorityWaitWeight       = 1.0
+	DefaultReviewPriorityApprovalWeight   = 0.1
+	DefaultReviewPriorityVotesWeight      = 0.2
+	DefaultReviewPriorityReputationWeight = 0.5
+	DefaultReviewConflictVoted        = "false"
+	DefaultReviewConflictRelationship = "true"
+	DefaultReviewConflictSharedDevice = "false"
+	DefaultReviewConflictDeviceDays    = 30
+	DefaultReviewConflictSharedIP      = "true"
+	DefaultReviewSLAEscalateHours  = 48
+	DefaultReviewSLAFallbackHours  = 96
+	DefaultReviewSLAFallbackAction = SLAFallbackWait
 )
 
 // SystemConfig 系统配置模型
@@ -77,5 +183,52 @@ func GetDefaultConfigs() []*SystemConfig {
 		{Key: ConfigLinuxDoRedirectURI, Value: "", Description: "LinuxDo OAuth回调地址"},
 		{Key: ConfigSiteName, Value: DefaultSiteName, Description: "站点名称"},
 		{Key: ConfigSiteURL, Value: "", Description: "站点URL"},
+		{Key: ConfigEditVotePolicy, Value: DefaultEditVotePolicy, Description: "实质性修改申请后对已有投票的处理方式(none/flag/reset)"},
+		{Key: ConfigEditSubstantialRate, Value: strconv.Itoa(DefaultEditSubstantialRate), Description: "判定为实质性修改的内容变化比例(百分比)"},
+		{Key: ConfigReapplyCooldownHours, Value: strconv.Itoa(DefaultReapplyCooldownHours), Description: "被拒绝后重新申请的基础冷却时间(小时，0表示不限制)"},
+		{Key: ConfigReapplyCooldownMultiplier, Value: strconv.Itoa(DefaultReapplyCooldownMultiplier), Description: "每多一次拒绝冷却时间的倍增系数"},
+		{Key: ConfigReapplyCooldownMaxHours, Value: strconv.Itoa(DefaultReapplyCooldownMaxHours), Description: "重新申请冷却时间上限(小时)"},
+		{Key: ConfigMaxApplyAttempts, Value: strconv.Itoa(DefaultMaxApplyAttempts), Description: "终身最多申请次数(0表示不限制，撤回的申请不计入)"},
+		{Key: ConfigCommentEditMinutes, Value: strconv.Itoa(DefaultCommentEditMinutes), Description: "评论发布后允许修改的时间窗口(分钟，0表示不允许修改)"},
+		{Key: ConfigCommentDeleteMinutes, Value: strconv.Itoa(DefaultCommentDeleteMinutes), Description: "评论发布后允许删除的时间窗口(分钟，0表示不允许删除)"},
+		{Key: ConfigDownvoteReasonRequired, Value: DefaultDownvoteReasonRequired, Description: "投反对票时是否必须选择原因分类或填写原因(true/false)"},
+		{Key: ConfigDownvoteReasonCategories, Value: DefaultDownvoteReasonCategories, Description: "反对原因分类(逗号分隔)"},
+		{Key: ConfigMarkdownNofollowLinks, Value: DefaultMarkdownNofollowLinks, Description: "渲染申请内容时为链接添加 rel=\"nofollow\"(true/false，修改后需重新渲染)"},
+		{Key: ConfigMarkdownImageProxyEnabled, Value: DefaultMarkdownImageProxyEnabled, Description: "是否通过代理加载申请内容中的外部图片(true/false，修改后需重新渲染)"},
+		{Key: ConfigMarkdownImageProxyURL, Value: "", Description: "图片代理地址前缀，原图地址经URL编码后拼接在末尾"},
+		{Key: ConfigSimilarityWarnThreshold, Value: strconv.Itoa(DefaultSimilarityWarnThreshold), Description: "申请与其他用户历史申请相似度超过该值时显示疑似重复警告(百分比)"},
+		{Key: ConfigSimilarityHoldThreshold, Value: strconv.Itoa(DefaultSimilarityHoldThreshold), Description: "相似度超过该值时自动暂停投票等待管理员处理(百分比，0表示不启用)"},
+		{Key: ConfigModerationEnabled, Value: DefaultModerationEnabled, Description: "新申请是否需要先由管理员或认证用户预审后再进入社区投票(true/false)"},
+		{Key: ConfigModerationAutoReleaseHours, Value: strconv.Itoa(DefaultModerationAutoReleaseHours), Description: "预审超过该时间未处理的申请自动放行到投票(小时，0表示不自动放行，被自动暂停的申请不会自动放行)"},
+		{Key: ConfigReportCategories, Value: DefaultReportCategories, Description: "举报分类(逗号分隔)"},
+		{Key: ConfigReportAutoHideThreshold, Value: strconv.Itoa(DefaultReportAutoHideThreshold), Description: "投票中的申请被多少名可信用户举报后自动隐藏等待管理员处理(0表示不启用)"},
+		{Key: ConfigReportTrustedLevel, Value: strconv.Itoa(DefaultReportTrustedLevel), Description: "举报计入自动隐藏所需的最低LinuxDo信任等级(管理员和认证用户始终计入)"},
+		{Key: ConfigVoteMinTrustLevel, Value: strconv.Itoa(DefaultVoteMinTrustLevel), Description: "投票计票所需的最低LinuxDo信任等级(不满足时投票仅记录不计票，管理员不受限制)"},
+		{Key: ConfigVoteMinAccountDays, Value: strconv.Itoa(DefaultVoteMinAccountDays), Description: "投票计票所需的最短注册天数(0表示不限制)"},
+		{Key: ConfigVoteMinPriorVotes, Value: strconv.Itoa(DefaultVoteMinPriorVotes), Description: "在其他申请上至少投过多少票后投票才计票(0表示不限制)"},
+		{Key: ConfigVoteRingMinShared, Value: strconv.Itoa(DefaultVoteRingMinShared), Description: "投票圈分析: 两名用户至少共同投过多少个申请才参与判定"},
+		{Key: ConfigVoteRingMinAgreement, Value: strconv.Itoa(DefaultVoteRingMinAgreement), Description: "投票圈分析: 共同投票的一致率阈值(百分比)"},
+		{Key: ConfigVoteRingWindowSeconds, Value: strconv.Itoa(DefaultVoteRingWindowSeconds), Description: "投票圈分析: 两次投票间隔不超过该秒数视为同时投票"},
+		{Key: ConfigVoteRingMinTimingRate, Value: strconv.Itoa(DefaultVoteRingMinTimingRate), Description: "投票圈分析: 同时投票比例阈值(百分比，0表示只看一致率)"},
+		{Key: ConfigReputationHalfLifeDays, Value: strconv.Itoa(DefaultReputationHalfLifeDays), Description: "投票者信誉的半衰期(天)，越早的投票对信誉的影响越小"},
+		{Key: ConfigReputationVoteWeight, Value: DefaultReputationVoteWeight, Description: "是否按投票者信誉加权计算赞率(true/false，票数门槛仍按人数计算)"},
+		{Key: ConfigVoteMinReputation, Value: "", Description: "投票计票所需的最低信誉分(为空表示不限制，管理员不受限制)"},
+		{Key: ConfigBlindVoting, Value: DefaultBlindVoting, Description: "盲投模式: 申请处于投票阶段时只有管理员能看到票数和赞率，进入下一阶段后公开(true/false)"},
+		{Key: ConfigReviewRequiredApprovals, Value: strconv.Itoa(DefaultReviewRequiredApprovals), Description: "二级审核通过所需的审核员同意人数(大于1时为多人审核模式，由最后一名同意的审核员提交邀请码)"},
+		{Key: ConfigReviewVetoCount, Value: strconv.Itoa(DefaultReviewVetoCount), Description: "二级审核中累计多少名审核员否决即拒绝申请"},
+		{Key: ConfigReviewLockMinutes, Value: strconv.Itoa(DefaultReviewLockMinutes), Description: "审核员领取申请后的锁定时长(分钟)，审核页面通过心跳续期，超时未续期自动释放"},
+		{Key: ConfigReviewSkipHours, Value: strconv.Itoa(DefaultReviewSkipHours), Description: "审核员跳过的申请多久后重新出现在其审核队列中(小时，0表示不过期，审核员可随时取消跳过)"},
+		{Key: ConfigReviewPriorityWaitWeight, Value: strconv.FormatFloat(DefaultReviewPriorityWaitWeight, 'f', -1, 64), Description: "审核队列优先级: 在二级审核中每等待1小时增加的分数"},
+		{Key: ConfigReviewPriorityApprovalWeight, Value: strconv.FormatFloat(DefaultReviewPriorityApprovalWeight, 'f', -1, 64), Description: "审核队列优先级: 赞率每1%增加的分数"},
+		{Key: ConfigReviewPriorityVotesWeight, Value: strconv.FormatFloat(DefaultReviewPriorityVotesWeight, 'f', -1, 64), Description: "审核队列优先级: 每张计票的投票增加的分数(开启信誉加权时按权重计算)"},
+		{Key: ConfigReviewPriorityReputationWeight, Value: strconv.FormatFloat(DefaultReviewPriorityReputationWeight, 'f', -1, 64), Description: "审核队列优先级: 申请者的投票者信誉每1分增加的分数(可为负)"},
+		{Key: ConfigReviewConflictVoted, Value: DefaultReviewConflictVoted, Description: "利益冲突: 审核员在投票阶段投过票的申请不分配给该审核员且不能由其通过(true/false)"},
+		{Key: ConfigReviewConflictRelationship, Value: DefaultReviewConflictRelationship, Description: "利益冲突: 审核员与申请者有管理员登记的关系时不能审核(true/false)"},
+		{Key: ConfigReviewConflictSharedDevice, Value: DefaultReviewConflictSharedDevice, Description: "利益冲突: 审核员与申请者近期登录时使用过相同的IP或设备指纹时不能审核(true/false)"},
+		{Key: ConfigReviewConflictDeviceDays, Value: strconv.Itoa(DefaultReviewConflictDeviceDays), Description: "利益冲突: 只比较最近多少天内登录使用的设备(天)"},
+		{Key: ConfigReviewConflictSharedIP, Value: DefaultReviewConflictSharedIP, Description: "利益冲突: 近期登录使用过相同IP也视为相同设备(同一网络出口下的用户可能被误判，可关闭后只比较设备指纹)(true/false)"},
+		{Key: ConfigReviewSLAEscalateHours, Value: strconv.Itoa(DefaultReviewSLAEscalateHours), Description: "审核时限: 申请进入二级审核超过该时间未处理时升级，在审核队列中突出显示并邮件通知管理员(小时，0表示不启用)"},
+		{Key: ConfigReviewSLAFallbackHours, Value: strconv.Itoa(DefaultReviewSLAFallbackHours), Description: "审核时限: 申请进入二级审核超过该时间仍未处理时执行兜底操作(小时，0表示不启用)"},
+		{Key: ConfigReviewSLAFallbackAction, Value: DefaultReviewSLAFallbackAction, Description: "审核时限: 兜底操作(notify_certified=邮件通知所有认证用户, return_to_voting=退回社区投票, wait=继续等待)"},
 	}
 }
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+)
+
+// 审核利益冲突原因
+const (
+	ConflictOwnPost      = "own_post"      // 审核自己的申请
+	ConflictVoted        = "voted"         // 审核员在投票阶段投过票
+	ConflictRelationship = "relationship"  // 审核员与申请者有登记的关系
+	ConflictSharedDevice = "shared_device" // 审核员与申请者使用过相同的IP或设备
+)
+
+// UserDevice 用户注册和登录时使用的IP及设备指纹(用于识别关联账号)
+type UserDevice struct {
+	ID          uint      `gorm:"primaryKey" json:"id"`
+	UserID      uint      `gorm:"uniqueIndex:idx_user_device" json:"user_id"`
+	IP          string    `gorm:"size:64;uniqueIndex:idx_user_device;index" json:"ip"`
+	Fingerprint string    `gorm:"size:128;uniqueIndex:idx_user_device;index" json:"fingerprint,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	LastSeenAt  time.Time `json:"last_seen_at"`
+}
+
+// TableName 指定表名
+func (UserDevice) TableName() string {
+	return "user_devices"
+}
+
+// UserRelationship 管理员登记的用户关系(如亲友、同事)，存在关系的用户不能互相审核
+// UserID 始终小于 RelatedUserID
+type UserRelationship struct {
+	ID            uint      `gorm:"primaryKey" json:"id"`
+	UserID        uint      `gorm:"uniqueIndex:idx_user_relationship" json:"user_id"`
+	User          *User     `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	RelatedUserID uint      `gorm:"uniqueIndex:idx_user_relationship;index" json:"related_user_id"`
+	RelatedUser   *User     `gorm:"foreignKey:RelatedUserID" json:"related_user,omitempty"`
+	Note          string    `gorm:"size:500" json:"note,omitempty"`
+	CreatedBy     uint      `json:"created_by"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// TableName 指定表名
+func (UserRelationship) TableName() string {
+	return "user_relationships"
+}
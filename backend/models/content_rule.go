@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+)
+
+// ContentRuleType 内容规则类型
+type ContentRuleType string
+
+const (
+	RuleTypeRegex         ContentRuleType = "regex"          // 正则表达式匹配
+	RuleTypeKeywords      ContentRuleType = "keywords"       // 关键词列表(每行一个，忽略大小写)
+	RuleTypeDistinctChars ContentRuleType = "distinct_chars" // 不同字符数少于阈值
+	RuleTypeLinkCount     ContentRuleType = "link_count"     // 链接数超过阈值
+	RuleTypeLanguageRatio ContentRuleType = "language_ratio" // 指定文字占比低于阈值(百分比)
+)
+
+// ContentRuleAction 命中规则后的处理方式
+type ContentRuleAction string
+
+const (
+	RuleActionReject ContentRuleAction = "reject" // 直接拒绝提交
+	RuleActionHold   ContentRuleAction = "hold"   // 进入待审核，等待人工放行
+	RuleActionFlag   ContentRuleAction = "flag"   // 仅标记，正常进入投票
+)
+
+// 语言占比规则支持的文字
+const (
+	RuleLanguageHan   = "han"   // 汉字
+	RuleLanguageLatin = "latin" // 拉丁字母
+)
+
+// ContentRule 新申请的内容规则
+type ContentRule struct {
+	ID        uint              `gorm:"primaryKey" json:"id"`
+	Name      string            `gorm:"size:100" json:"name"`
+	Type      ContentRuleType   `gorm:"size:30" json:"type"`
+	Pattern   string            `gorm:"type:text" json:"pattern"` // 正则表达式、关键词列表或语言(han/latin)
+	Threshold float64           `json:"threshold"`                // 不同字符数、链接数或语言占比阈值
+	Action    ContentRuleAction `gorm:"size:20" json:"action"`
+	Message   string            `gorm:"size:255" json:"message"` // 命中时展示给申请者或审核者的说明
+	Enabled   bool              `json:"enabled"`
+	CreatedBy uint              `json:"created_by"`
+	CreatedAt time.Time         `json:"created_at"`
+	UpdatedAt time.Time         `json:"updated_at"`
+}
+
+// TableName 指定表名
+func (ContentRule) TableName() string {
+	return "content_rules"
+}
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+)
+
+// ApplyExemption 申请限制豁免(管理员授予，豁免期间不受重新申请冷却期和申请次数上限限制)
+type ApplyExemption struct {
+	ID        uint       `gorm:"primaryKey" json:"id"`
+	UserID    uint       `gorm:"uniqueIndex" json:"user_id"`
+	GrantedBy uint       `json:"granted_by"` // 授予豁免的管理员ID
+	Reason    string     `gorm:"size:500" json:"reason,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"` // 过期时间(为空表示永久有效)
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// TableName 指定表名
+func (ApplyExemption) TableName() string {
+	return "apply_exemptions"
+}
+
+// IsActive 豁免是否仍然有效
+func (e *ApplyExemption) IsActive() bool {
+	return e != nil && (e.ExpiresAt == nil || time.Now().Before(*e.ExpiresAt))
+}
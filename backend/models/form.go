@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+)
+
+// 申请表问题类型
+const (
+	FormQuestionText   = "text"   // 文本
+	FormQuestionChoice = "choice" // 单选
+	FormQuestionURL    = "url"    // 链接
+)
+
+// FormQuestion 申请表问题
+type FormQuestion struct {
+	Key         string   `json:"key"`   // 问题标识(同一版本内唯一)
+	Label       string   `json:"label"` // 问题标题
+	Description string   `json:"description,omitempty"`
+	Type        string   `json:"type"`
+	Required    bool     `json:"required"`
+	Options     []string `json:"options,omitempty"`    // 单选题选项
+	MinLength   int      `json:"min_length,omitempty"` // 最少字符数(仅文本题)
+	MaxLength   int      `json:"max_length,omitempty"` // 最多字符数，0表示使用默认上限
+}
+
+// ApplicationForm 申请表(每次修改生成新版本，旧版本保留用于展示历史申请)
+type ApplicationForm struct {
+	ID        uint           `gorm:"primaryKey" json:"id"`
+	Version   int            `gorm:"uniqueIndex" json:"version"`
+	Questions []FormQuestion `gorm:"type:text;serializer:json" json:"questions"` // 按顺序排列的问题，为空表示使用自由填写
+	CreatedBy uint           `json:"created_by"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+// TableName 指定表名
+func (ApplicationForm) TableName() string {
+	return "application_forms"
+}
+
+// HasQuestions 是否定义了问题(没有问题时申请使用自由填写的内容)
+func (f *ApplicationForm) HasQuestions() bool {
+	return f != nil && len(f.Questions) > 0
+}
+
+// PostAnswer 申请表回答(保存提交时的问题标题和类型，表单更新后仍能按原问题展示)
+type PostAnswer struct {
+	Key   string `json:"key"`
+	Label string `json:"label"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
@@ -13,27 +13,46 @@ const (
 	StatusSecondReview PostStatus = 2 // 二级审核(等待认证用户提交邀请码)
 	StatusApproved     PostStatus = 3 // 已通过
 	StatusRejected     PostStatus = 4 // 已拒绝
+	StatusWithdrawn    PostStatus = 5 // 申请者已撤回
 )
 
 // Post 帖子/申请模型
 type Post struct {
-	ID           uint       `gorm:"primaryKey" json:"id"`
-	UserID       uint       `gorm:"index" json:"user_id"`
-	User         *User      `gorm:"foreignKey:UserID" json:"user,omitempty"`
-	Title        string     `gorm:"size:255" json:"title"`
-	Content      string     `gorm:"type:text" json:"content"`
-	Status       PostStatus `gorm:"default:1;index" json:"status"`
-	UpVotes      int        `gorm:"default:0" json:"up_votes"`
-	DownVotes    int        `gorm:"default:0" json:"down_votes"`
-	ReviewerID   *uint      `gorm:"index" json:"reviewer_id,omitempty"`
-	Reviewer     *User      `gorm:"foreignKey:ReviewerID" json:"reviewer,omitempty"`
-	InviteCode   string     `gorm:"size:255" json:"-"`                       // 邀请码(加密存储,不返回给前端)
-	RejectReason string     `gorm:"size:500" json:"reject_reason,omitempty"` // 拒绝原因
-	ReviewedAt   *time.Time `json:"reviewed_at,omitempty"`                   // 审核时间
-	LockedBy     *uint      `gorm:"index" json:"locked_by,omitempty"`        // 锁定者ID(防止并发操作)
-	LockedAt     *time.Time `json:"locked_at,omitempty"`                     // 锁定时间
-	CreatedAt    time.Time  `json:"created_at"`
-	UpdatedAt    time.Time  `json:"updated_at"`
+	ID                uint         `gorm:"primaryKey" json:"id"`
+	UserID            uint         `gorm:"index" json:"user_id"`
+	User              *User        `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	Title             string       `gorm:"size:255" json:"title"`
+	Content           string       `gorm:"type:text" json:"content"`      // Markdown 原文
+	ContentHTML       string       `gorm:"type:text" json:"content_html"` // 渲染并过滤后的安全 HTML
+	Status            PostStatus   `gorm:"default:1;index" json:"status"`
+	UpVotes           int          `gorm:"default:0" json:"up_votes"`
+	DownVotes         int          `gorm:"default:0" json:"down_votes"`
+	ReviewerID        *uint        `gorm:"index" json:"reviewer_id,omitempty"`
+	Reviewer          *User        `gorm:"foreignKey:ReviewerID" json:"reviewer,omitempty"`
+	InviteCode        string       `gorm:"size:255" json:"-"`                                  // 邀请码(加密存储,不返回给前端)
+	RejectReason      string       `gorm:"size:500" json:"reject_reason,omitempty"`            // 拒绝原因
+	ReviewedAt        *time.Time   `json:"reviewed_at,omitempty"`                              // 审核时间
+	LockedBy          *uint        `gorm:"index" json:"locked_by,omitempty"`                   // 锁定者ID(防止并发操作)
+	LockedAt          *time.Time   `json:"locked_at,omitempty"`                                // 锁定时间
+	EditCount         int          `gorm:"default:0" json:"edit_count"`                        // 修改次数
+	EditedAt          *time.Time   `json:"edited_at,omitempty"`                                // 最后修改时间
+	CommentCount      int          `gorm:"default:0" json:"comment_count"`                     // 可见评论数
+	CommentsLocked    bool         `gorm:"default:false" json:"comments_locked"`               // 评论区是否被管理员锁定
+	FormVersion       int          `gorm:"default:0" json:"form_version"`                      // 填写的申请表版本，0表示自由填写
+	Answers           []PostAnswer `gorm:"type:text;serializer:json" json:"answers,omitempty"` // 申请表回答
+	SimilarityScore   float64      `gorm:"default:0" json:"similarity_score"`                  // 与其他用户历史帖子的最高相似度(百分比)
+	PossibleDuplicate bool         `gorm:"default:false" json:"possible_duplicate"`            // 相似度超过警告阈值
+	HeldAt            *time.Time   `json:"held_at,omitempty"`                                  // 进入待审核状态的时间
+	HoldReason        string       `gorm:"size:255" json:"hold_reason,omitempty"`              // 被自动暂停的原因，为空表示常规预审
+	RuleFlags         string       `gorm:"size:500" json:"rule_flags,omitempty"`               // 命中的标记类内容规则
+	SecondReviewAt    *time.Time   `json:"second_review_at,omitempty"`                         // 进入二级审核的时间
+	PriorityBoost     float64      `gorm:"default:0" json:"priority_boost"`                    // 管理员设置的审核队列优先级加成
+	EscalatedAt       *time.Time   `json:"escalated_at,omitempty"`                             // 本轮二级审核超过时限被升级的时间
+	SLAFallbackAt     *time.Time   `json:"sla_fallback_at,omitempty"`                          // 本轮二级审核超过兜底时限执行兜底操作的时间
+	SLAFallbackAction string       `gorm:"size:30" json:"sla_fallback_action,omitempty"`       // 执行的兜底操作
+	EscalationCount   int          `gorm:"default:0" json:"escalation_count"`                  // 累计被升级的次数
+	CreatedAt         time.Time    `json:"created_at"`
+	UpdatedAt         time.Time    `json:"updated_at"`
 }
 
 // TableName 指定表名
@@ -60,16 +79,44 @@ func (p *Post) CanVote() bool {
 	return p.Status == StatusFirstReview
 }
 
+// CanEdit 是否可以修改(只有投票进行中的帖子可以由申请者修改)
+func (p *Post) CanEdit() bool {
+	return p.Status == StatusFirstReview
+}
+
 // CanApprove 是否可以通过审核(只有二级审核的帖子可以通过)
 func (p *Post) CanApprove() bool {
 	return p.Status == StatusSecondReview
 }
 
-// CanReject 是否可以拒绝(一级或二级审核中的帖子可以被管理员拒绝)
+// CanReject 是否可以拒绝(待审核、一级或二级审核中的帖子可以被管理员拒绝)
 func (p *Post) CanReject() bool {
+	return p.Status == StatusPending || p.Status == StatusFirstReview || p.Status == StatusSecondReview
+}
+
+// CanWithdraw 是否可以撤回(待审核、一级或二级审核中的帖子可以被申请者撤回)
+func (p *Post) CanWithdraw() bool {
+	return p.Status == StatusPending || p.Status == StatusFirstReview || p.Status == StatusSecondReview
+}
+
+// CanComment 是否可以评论(公开审核中且评论区未被锁定，待审核的帖子尚未公开不能评论)
+func (p *Post) CanComment() bool {
+	if p.CommentsLocked {
+		return false
+	}
 	return p.Status == StatusFirstReview || p.Status == StatusSecondReview
 }
 
+// VisibleTo 帖子是否对指定用户可见(待审核的帖子只有申请者本人、管理员和认证用户可以查看)
+func (p *Post) VisibleTo(userID uint, moderator bool) bool {
+	return p.Status != StatusPending || p.UserID == userID || moderator
+}
+
+// IsEscalated 本轮二级审核是否已超过时限被升级
+func (p *Post) IsEscalated() bool {
+	return p.Status == StatusSecondReview && p.EscalatedAt != nil
+}
+
 // ShouldPromoteToSecondReview 检查是否应该进入二级审核
 func (p *Post) ShouldPromoteToSecondReview(minVotes int, approvalRate float64) bool {
 	return p.TotalVotes() >= minVotes && p.ApprovalRate() >= approvalRate
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+)
+
+// 帖子历史事件类型
+const (
+	PostEventCreate       = "create"        // 提交申请
+	PostEventEdit         = "edit"          // 修改申请
+	PostEventPromote      = "promote"       // 投票通过进入二级审核
+	PostEventApprove      = "approve"       // 审核通过
+	PostEventReject       = "reject"        // 拒绝
+	PostEventWithdraw     = "withdraw"      // 申请者撤回
+	PostEventAppeal       = "appeal"        // 申请者提交申诉
+	PostEventAppealAccept = "appeal_accept" // 申诉被接受，申请重新开启
+	PostEventAppealDeny   = "appeal_deny"   // 申诉被驳回
+	PostEventHold         = "hold"          // 暂停投票等待管理员处理
+	PostEventRelease      = "release"       // 放行到社区投票
+	PostEventFlag         = "flag"          // 命中内容规则被标记
+	PostEventEscalate     = "escalate"      // 二级审核超过时限被升级
+	PostEventSLAFallback  = "sla_fallback"  // 二级审核超过兜底时限执行兜底操作
+)
+
+// PostEvent 帖子历史事件
+type PostEvent struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	PostID    uint      `gorm:"index" json:"post_id"`
+	UserID    uint      `gorm:"index" json:"user_id"` // 操作者ID，0表示系统自动操作
+	User      *User     `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	Action    string    `gorm:"size:50;index" json:"action"`
+	Note      string    `gorm:"size:500" json:"note,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName 指定表名
+func (PostEvent) TableName() string {
+	return "post_events"
+}
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"time"
+)
+
+// ReportTargetType 举报对象类型
+type ReportTargetType string
+
+const (
+	ReportTargetPost    ReportTargetType = "post"    // 申请
+	ReportTargetComment ReportTargetType = "comment" // 评论
+	ReportTargetUser    ReportTargetType = "user"    // 用户
+)
+
+// ReportStatus 举报处理状态
+type ReportStatus int
+
+const (
+	ReportOpen      ReportStatus = 0 // 待处理
+	ReportResolved  ReportStatus = 1 // 已处理(举报属实)
+	ReportDismissed ReportStatus = 2 // 已驳回
+)
+
+// Report 举报模型(同一用户对同一对象只能举报一次)
+type Report struct {
+	ID         uint             `gorm:"primaryKey" json:"id"`
+	ReporterID uint             `gorm:"uniqueIndex:idx_report_target" json:"reporter_id"`
+	Reporter   *User            `gorm:"foreignKey:ReporterID" json:"reporter,omitempty"`
+	TargetType ReportTargetType `gorm:"size:20;uniqueIndex:idx_report_target;index:idx_report_lookup" json:"target_type"`
+	TargetID   uint             `gorm:"uniqueIndex:idx_report_target;index:idx_report_lookup" json:"target_id"`
+	PostID     uint             `gorm:"index" json:"post_id,omitempty"` // 被举报内容所属的申请，举报用户时为0
+	Category   string           `gorm:"size:50" json:"category"`
+	Note       string           `gorm:"size:1000" json:"note,omitempty"`
+	Status     ReportStatus     `gorm:"default:0;index" json:"status"`
+	HandlerID  *uint            `json:"handler_id,omitempty"`
+	Handler    *User            `gorm:"foreignKey:HandlerID" json:"handler,omitempty"`
+	Resolution string           `gorm:"size:500" json:"resolution,omitempty"` // 处理说明
+	HandledAt  *time.Time       `json:"handled_at,omitempty"`
+	CreatedAt  time.Time        `json:"created_at"`
+	UpdatedAt  time.Time        `json:"updated_at"`
+}
+
+// TableName 指定表名
+func (Report) TableName() string {
+	return "reports"
+}
+
+// IsOpen 是否待处理
+func (r *Report) IsOpen() bool {
+	return r.Status == ReportOpen
+}
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+)
+
+// VoterReputation 投票者信誉(投票与最终结果一致加分，不一致扣分，随时间衰减)
+type VoterReputation struct {
+	UserID     uint      `gorm:"primaryKey" json:"user_id"`
+	User       *User     `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	Score      float64   `json:"score"`      // DecayedAt 时刻的分数
+	Aligned    int       `json:"aligned"`    // 与最终结果一致的投票数
+	Misaligned int       `json:"misaligned"` // 与最终结果不一致的投票数
+	DecayedAt  time.Time `json:"decayed_at"` // 分数最后一次结算衰减的时间
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// TableName 指定表名
+func (VoterReputation) TableName() string {
+	return "voter_reputations"
+}
+
+// Settled 已结算的投票总数
+func (r *VoterReputation) Settled() int {
+	return r.Aligned + r.Misaligned
+}
+
+// Accuracy 投票准确率(百分比)
+func (r *VoterReputation) Accuracy() float64 {
+	if r.Settled() == 0 {
+		return 0
+	}
+	return float64(r.Aligned) / float64(r.Settled()) * 100
+}
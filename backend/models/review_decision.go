@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+)
+
+// ReviewDecisionType 审核员意见类型
+type ReviewDecisionType string
+
+const (
+	ReviewDecisionApprove ReviewDecisionType = "approve" // 同意通过
+	ReviewDecisionReject  ReviewDecisionType = "reject"  // 否决
+)
+
+// ReviewDecision 二级审核中审核员提交的意见
+// 多人审核模式下申请需要多名审核员同意才能通过，审核结束后本轮意见关闭
+// 申诉重新开启的申请会重新收集意见
+type ReviewDecision struct {
+	ID         uint               `gorm:"primaryKey" json:"id"`
+	PostID     uint               `gorm:"index:idx_review_decision_post" json:"post_id"`
+	ReviewerID uint               `gorm:"index" json:"reviewer_id"`
+	Reviewer   *User              `gorm:"foreignKey:ReviewerID" json:"reviewer,omitempty"`
+	Decision   ReviewDecisionType `gorm:"size:20" json:"decision"`
+	Note       string             `gorm:"size:1000" json:"note,omitempty"`
+	Closed     bool               `gorm:"index:idx_review_decision_post" json:"closed"` // 所在轮次的审核是否已结束
+	CreatedAt  time.Time          `json:"created_at"`
+}
+
+// TableName 指定表名
+func (ReviewDecision) TableName() string {
+	return "review_decisions"
+}
+
+// IsApprove 是否为同意意见
+func (d *ReviewDecision) IsApprove() bool {
+	return d.Decision == ReviewDecisionApprove
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+)
+
+// 审核锁定释放原因
+const (
+	LockReleaseSkip     = "skip"     // 审核员跳过
+	LockReleaseExpiry   = "expiry"   // 超时未续期
+	LockReleaseDecision = "decision" // 审核员提交了审核意见
+	LockReleaseAdmin    = "admin"    // 管理员强制释放
+	LockReleaseSLA      = "sla"      // 超过审核时限退回社区投票
+	LockReleaseWithdraw = "withdraw" // 申请者撤回申请
+)
+
+// ReviewLock 二级审核锁定记录(审核员领取申请到释放的过程)
+type ReviewLock struct {
+	ID            uint       `gorm:"primaryKey" json:"id"`
+	PostID        uint       `gorm:"index" json:"post_id"`
+	Post          *Post      `gorm:"foreignKey:PostID" json:"post,omitempty"`
+	UserID        uint       `gorm:"index" json:"user_id"`
+	User          *User      `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	LockedAt      time.Time  `json:"locked_at"`                               // 领取时间
+	ReleasedAt    *time.Time `gorm:"index" json:"released_at,omitempty"`      // 释放时间，为空表示仍在锁定中
+	ReleaseReason string     `gorm:"size:20" json:"release_reason,omitempty"` // 释放原因
+	ReleasedBy    *uint      `json:"released_by,omitempty"`                   // 强制释放的管理员
+}
+
+// TableName 指定表名
+func (ReviewLock) TableName() string {
+	return "review_locks"
+}
+
+// IsActive 是否仍在锁定中
+func (l *ReviewLock) IsActive() bool {
+	return l.ReleasedAt == nil
+}
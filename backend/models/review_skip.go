@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+)
+
+// ReviewSkip 审核员跳过的申请(同一审核员对同一申请只保留一条记录)
+type ReviewSkip struct {
+	ID        uint       `gorm:"primaryKey" json:"id"`
+	UserID    uint       `gorm:"uniqueIndex:idx_review_skip" json:"user_id"`
+	PostID    uint       `gorm:"uniqueIndex:idx_review_skip;index" json:"post_id"`
+	Post      *Post      `gorm:"foreignKey:PostID" json:"post,omitempty"`
+	Times     int        `gorm:"default:1" json:"times"` // 跳过次数
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`   // 过期后重新出现在审核队列中，为空表示不过期
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"` // 最近一次跳过的时间
+}
+
+// TableName 指定表名
+func (ReviewSkip) TableName() string {
+	return "review_skips"
+}
+
+// IsActive 跳过是否仍然有效
+func (s *ReviewSkip) IsActive(now time.Time) bool {
+	return s.ExpiresAt == nil || s.ExpiresAt.After(now)
+}
+
+// SkippedPostStat 申请被跳过的统计
+type SkippedPostStat struct {
+	PostID    uint  `json:"post_id"`
+	Reviewers int64 `json:"reviewers"` // 跳过的审核员人数
+	Skips     int64 `json:"skips"`     // 累计跳过次数
+}
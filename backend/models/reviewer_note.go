@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+)
+
+// ReviewerNote 审核员内部备注(仅认证用户和管理员可见，不对申请者展示)
+type ReviewerNote struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	PostID    uint      `gorm:"index" json:"post_id"`
+	UserID    uint      `json:"user_id"`
+	User      *User     `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	Content   string    `gorm:"type:text" json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName 指定表名
+func (ReviewerNote) TableName() string {
+	return "reviewer_notes"
+}
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+)
+
+// PostRevision 帖子修订记录(创建后不可修改)
+type PostRevision struct {
+	ID          uint      `gorm:"primaryKey" json:"id"`
+	PostID      uint      `gorm:"index;uniqueIndex:idx_post_version" json:"post_id"`
+	Version     int       `gorm:"uniqueIndex:idx_post_version" json:"version"` // 版本号，1为原始内容
+	EditorID    uint      `gorm:"index" json:"editor_id"`
+	Editor      *User     `gorm:"foreignKey:EditorID" json:"editor,omitempty"`
+	Title       string    `gorm:"size:255" json:"title"`
+	Content     string    `gorm:"type:text" json:"content"`
+	ChangeRate  float64   `json:"change_rate"`                      // 相对上一版本的内容变化比例(百分比)
+	Substantial bool      `gorm:"default:false" json:"substantial"` // 是否为实质性修改
+	VotePolicy  string    `gorm:"size:20" json:"vote_policy"`       // 修改时对已有投票的处理方式
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// TableName 指定表名
+func (PostRevision) TableName() string {
+	return "post_revisions"
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+)
+
+// PostSignature 帖子内容的 MinHash 签名(用于重复检测)
+type PostSignature struct {
+	PostID    uint      `gorm:"primaryKey" json:"post_id"`
+	UserID    uint      `gorm:"index" json:"user_id"`
+	Signature []byte    `json:"-"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName 指定表名
+func (PostSignature) TableName() string {
+	return "post_signatures"
+}
+
+// PostSimilarity 帖子与历史帖子的相似匹配记录
+type PostSimilarity struct {
+	ID          uint      `gorm:"primaryKey" json:"id"`
+	PostID      uint      `gorm:"uniqueIndex:idx_post_match" json:"post_id"`
+	MatchPostID uint      `gorm:"uniqueIndex:idx_post_match;index" json:"match_post_id"`
+	MatchPost   *Post     `gorm:"foreignKey:MatchPostID" json:"match_post,omitempty"`
+	Score       float64   `json:"score"` // 相似度(百分比)
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// TableName 指定表名
+func (PostSimilarity) TableName() string {
+	return "post_similarities"
+}
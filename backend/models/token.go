@@ -0,0 +1,64 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// APITokenPrefix 个人访问令牌前缀(用于与JWT区分)
+const APITokenPrefix = "ldr_"
+
+// 令牌权限范围
+const (
+	ScopeReadPosts = "read:posts" // 读取申请列表和详情
+	ScopeReview    = "review"     // 二级审核操作
+	ScopeAdminRead = "admin:read" // 只读访问管理后台
+)
+
+// APIToken 个人访问令牌模型
+type APIToken struct {
+	ID          uint       `gorm:"primaryKey" json:"id"`
+	UserID      uint       `gorm:"index" json:"user_id"`
+	User        *User      `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	Name        string     `gorm:"size:100" json:"name"`
+	TokenHash   string     `gorm:"size:64;uniqueIndex" json:"-"` // 令牌SHA-256哈希(明文只在创建时返回一次)
+	TokenPrefix string     `gorm:"size:20" json:"token_prefix"`  // 令牌前几位,用于识别
+	Scopes      string     `gorm:"size:255" json:"scopes"`       // 逗号分隔的权限范围
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`         // 过期时间(为空表示永不过期)
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`       // 最后使用时间
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// TableName 指定表名
+func (APIToken) TableName() string {
+	return "api_tokens"
+}
+
+// ScopeList 获取权限范围列表
+func (t *APIToken) ScopeList() []string {
+	if t.Scopes == "" {
+		return nil
+	}
+	return strings.Split(t.Scopes, ",")
+}
+
+// IsExpired 是否已过期
+func (t *APIToken) IsExpired() bool {
+	return t.ExpiresAt != nil && time.Now().After(*t.ExpiresAt)
+}
+
+// IsValidScope 是否为有效的权限范围
+func IsValidScope(scope string) bool {
+	switch scope {
+	case ScopeReadPosts, ScopeReview, ScopeAdminRead:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsReadOnlyScope 是否为只读权限范围(只允许GET请求)
+func IsReadOnlyScope(scope string) bool {
+	return scope == ScopeReadPosts || scope == ScopeAdminRead
+}
@@ -23,7 +23,9 @@ type User struct {
 	LinuxDoID       string    `gorm:"size:100;index" json:"linuxdo_id,omitempty"`
 	LinuxDoUsername string    `gorm:"size:100" json:"linuxdo_username,omitempty"`
 	AvatarURL       string    `gorm:"size:500" json:"avatar_url,omitempty"`
-	TrustLevel      int       `gorm:"default:0" json:"trust_level"` // LinuxDo信任等级
+	TrustLevel      int       `gorm:"default:0" json:"trust_level"`          // LinuxDo信任等级
+	LinuxDoSilenced bool      `gorm:"default:false" json:"linuxdo_silenced"` // LinuxDo账号是否被禁言
+	LinuxDoInactive bool      `gorm:"default:false" json:"linuxdo_inactive"` // LinuxDo账号是否未激活
 	CreatedAt       time.Time `json:"created_at"`
 	UpdatedAt       time.Time `json:"updated_at"`
 }
@@ -48,14 +50,19 @@ func (u *User) IsLinuxDoUser() bool {
 	return u.LinuxDoID != ""
 }
 
-// CanVote 是否可以投票(所有登录用户都可以投票)
+// IsLinuxDoRestricted 绑定的LinuxDo账号是否处于禁言或未激活状态
+func (u *User) IsLinuxDoRestricted() bool {
+	return u.IsLinuxDoUser() && (u.LinuxDoSilenced || u.LinuxDoInactive)
+}
+
+// CanVote 是否可以投票(所有登录用户都可以投票，LinuxDo账号受限的除外)
 func (u *User) CanVote() bool {
-	return u.ID > 0
+	return u.ID > 0 && !u.IsLinuxDoRestricted()
 }
 
-// CanApprove 是否可以通过审核(只有认证用户可以)
+// CanApprove 是否可以通过审核(只有认证用户可以，LinuxDo账号受限的除外)
 func (u *User) CanApprove() bool {
-	return u.IsCertified()
+	return u.IsCertified() && !u.IsLinuxDoRestricted()
 }
 
 // CanManage 是否可以管理(只有管理员可以)
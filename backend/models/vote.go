@@ -12,16 +12,32 @@ const (
 	VoteDown VoteType = -1 // 踩
 )
 
+// 投票不计票的原因分类
+const (
+	IneligibleTrustLevel = "trust_level" // 信任等级不足
+	IneligibleAccountAge = "account_age" // 注册时间不足
+	IneligiblePriorVotes = "prior_votes" // 在其他申请上的投票数不足
+	IneligibleReputation = "reputation"  // 投票信誉分不足
+	IneligibleVoteRing   = "vote_ring"   // 所在投票圈已被管理员作废
+)
+
 // Vote 投票模型
 type Vote struct {
-	ID        uint      `gorm:"primaryKey" json:"id"`
-	PostID    uint      `gorm:"index;uniqueIndex:idx_post_user" json:"post_id"`
-	Post      *Post     `gorm:"foreignKey:PostID" json:"post,omitempty"`
-	UserID    uint      `gorm:"index;uniqueIndex:idx_post_user" json:"user_id"`
-	User      *User     `gorm:"foreignKey:UserID" json:"user,omitempty"`
-	VoteType  VoteType  `json:"vote_type"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID             uint      `gorm:"primaryKey" json:"id"`
+	PostID         uint      `gorm:"index;uniqueIndex:idx_post_user" json:"post_id"`
+	Post           *Post     `gorm:"foreignKey:PostID" json:"post,omitempty"`
+	UserID         uint      `gorm:"index;uniqueIndex:idx_post_user" json:"user_id"`
+	User           *User     `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	VoteType       VoteType  `json:"vote_type"`
+	Stale          bool      `gorm:"default:false" json:"stale"`                // 投票后申请内容发生了实质性修改
+	ReasonCategory string    `gorm:"size:50" json:"reason_category,omitempty"`  // 反对原因分类(仅反对票)
+	Reason         string    `gorm:"size:500" json:"reason,omitempty"`          // 反对原因说明(仅反对票)
+	Ineligible     bool      `gorm:"default:false;index" json:"ineligible"`     // 投票者不满足资格要求，投票仅记录不计票
+	IneligibleCode string    `gorm:"size:20" json:"ineligible_code,omitempty"`  // 不计票的原因分类
+	IneligibleNote string    `gorm:"size:255" json:"ineligible_note,omitempty"` // 不计票的原因
+	Settled        bool      `gorm:"default:false" json:"-"`                    // 申请结束后已计入投票者信誉
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
 }
 
 // TableName 指定表名
@@ -39,6 +55,12 @@ func (v *Vote) IsDownVote() bool {
 	return v.VoteType == VoteDown
 }
 
+// DownvoteReasonCount 反对原因分类统计
+type DownvoteReasonCount struct {
+	Category string `json:"category"`
+	Count    int64  `json:"count"`
+}
+
 // GetVoteTypeText 获取投票类型文本
 func (v *Vote) GetVoteTypeText() string {
 	switch v.VoteType {
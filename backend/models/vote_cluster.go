@@ -0,0 +1,64 @@
+package models
+
+import (
+	"time"
+)
+
+// VoteClusterStatus 投票圈处理状态
+type VoteClusterStatus int
+
+const (
+	VoteClusterOpen       VoteClusterStatus = 0 // 待处理
+	VoteClusterDiscounted VoteClusterStatus = 1 // 已作废相关投票
+	VoteClusterDismissed  VoteClusterStatus = 2 // 已忽略(误报)
+)
+
+// VoteClusterPair 投票圈内两名用户的共同投票统计
+type VoteClusterPair struct {
+	UserA      uint    `json:"user_a"`
+	UserB      uint    `json:"user_b"`
+	Shared     int     `json:"shared"`      // 共同投票的申请数
+	Agreement  float64 `json:"agreement"`   // 投票一致率(百分比)
+	TimingRate float64 `json:"timing_rate"` // 同时投票比例(百分比)
+}
+
+// VoteCluster 投票圈分析发现的可疑用户群体
+type VoteCluster struct {
+	ID           uint              `gorm:"primaryKey" json:"id"`
+	MemberIDs    []uint            `gorm:"type:text;serializer:json" json:"member_ids"`
+	Pairs        []VoteClusterPair `gorm:"type:text;serializer:json" json:"pairs"`
+	PostIDs      []uint            `gorm:"type:text;serializer:json" json:"post_ids"` // 至少两名成员投了相同票的申请
+	AvgAgreement float64           `json:"avg_agreement"`
+	AvgTiming    float64           `json:"avg_timing"`
+	Status       VoteClusterStatus `gorm:"default:0;index" json:"status"`
+	HandlerID    *uint             `json:"handler_id,omitempty"`
+	Handler      *User             `gorm:"foreignKey:HandlerID" json:"handler,omitempty"`
+	Discounted   int               `gorm:"default:0" json:"discounted"` // 作废的投票数
+	HandledAt    *time.Time        `json:"handled_at,omitempty"`
+	CreatedAt    time.Time         `json:"created_at"`
+	UpdatedAt    time.Time         `json:"updated_at"`
+}
+
+// TableName 指定表名
+func (VoteCluster) TableName() string {
+	return "vote_clusters"
+}
+
+// IsOpen 是否待处理
+func (c *VoteCluster) IsOpen() bool {
+	return c.Status == VoteClusterOpen
+}
+
+// VoteRingDiscount 投票圈作废记录，成员此后在相关申请上的投票(含修改和重新投票)一律不计票
+type VoteRingDiscount struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	ClusterID uint      `gorm:"index" json:"cluster_id"`
+	UserID    uint      `gorm:"uniqueIndex:idx_discount_user_post" json:"user_id"`
+	PostID    uint      `gorm:"uniqueIndex:idx_discount_user_post" json:"post_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName 指定表名
+func (VoteRingDiscount) TableName() string {
+	return "vote_ring_discounts"
+}
@@ -0,0 +1,165 @@
+// Package collusion 根据投票记录发现经常一起投票的用户群体(投票圈)
+package collusion
+
+import (
+	"sort"
+	"time"
+)
+
+// Vote 一条投票记录
+type Vote struct {
+	UserID   uint
+	PostID   uint
+	VoteType int
+	At       time.Time
+}
+
+// Options 判定参数
+type Options struct {
+	MinShared     int           // 两人至少共同投过多少个申请才参与判定
+	MinAgreement  float64       // 投票一致率阈值(百分比)
+	TimeWindow    time.Duration // 两次投票间隔不超过该时间视为同时投票
+	MinTimingRate float64       // 同时投票比例阈值(百分比，0表示不要求)
+}
+
+// Pair 两名用户之间的共同投票统计
+type Pair struct {
+	UserA      uint    `json:"user_a"`
+	UserB      uint    `json:"user_b"`
+	Shared     int     `json:"shared"`      // 共同投票的申请数
+	Agreement  float64 `json:"agreement"`   // 投票一致率(百分比)
+	TimingRate float64 `json:"timing_rate"` // 同时投票比例(百分比)
+}
+
+// Cluster 由可疑用户对连通形成的群体
+type Cluster struct {
+	Members []uint // 按用户ID升序
+	Pairs   []Pair // 群体内的可疑用户对
+}
+
+type pairKey struct {
+	a, b uint
+}
+
+type pairStats struct {
+	shared, agreed, timed int
+}
+
+// Analyze 计算两两之间的共同投票一致率和时间相关性，返回可疑用户对组成的群体
+// 群体按成员数降序排列
+func Analyze(votes []Vote, opts Options) []Cluster {
+	byPost := make(map[uint][]Vote)
+	for _, v := range votes {
+		byPost[v.PostID] = append(byPost[v.PostID], v)
+	}
+
+	stats := make(map[pairKey]*pairStats)
+	for _, postVotes := range byPost {
+		for i := 0; i < len(postVotes); i++ {
+			for j := i + 1; j < len(postVotes); j++ {
+				x, y := postVotes[i], postVotes[j]
+				if x.UserID == y.UserID {
+					continue
+				}
+				key := pairKey{x.UserID, y.UserID}
+				if key.a > key.b {
+					key.a, key.b = key.b, key.a
+				}
+				st := stats[key]
+				if st == nil {
+					st = &pairStats{}
+					stats[key] = st
+				}
+				st.shared++
+				if x.VoteType == y.VoteType {
+					st.agreed++
+				}
+				if absDuration(x.At.Sub(y.At)) <= opts.TimeWindow {
+					st.timed++
+				}
+			}
+		}
+	}
+
+	var flagged []Pair
+	for key, st := range stats {
+		if st.shared < opts.MinShared {
+			continue
+		}
+		pair := Pair{
+			UserA:      key.a,
+			UserB:      key.b,
+			Shared:     st.shared,
+			Agreement:  float64(st.agreed) / float64(st.shared) * 100,
+			TimingRate: float64(st.timed) / float64(st.shared) * 100,
+		}
+		if pair.Agreement < opts.MinAgreement || pair.TimingRate < opts.MinTimingRate {
+			continue
+		}
+		flagged = append(flagged, pair)
+	}
+
+	return buildClusters(flagged)
+}
+
+// buildClusters 使用并查集将可疑用户对合并为群体
+func buildClusters(pairs []Pair) []Cluster {
+	parent := make(map[uint]uint)
+	var find func(uint) uint
+	find = func(x uint) uint {
+		if parent[x] != x {
+			parent[x] = find(parent[x])
+		}
+		return parent[x]
+	}
+	for _, p := range pairs {
+		for _, id := range []uint{p.UserA, p.UserB} {
+			if _, ok := parent[id]; !ok {
+				parent[id] = id
+			}
+		}
+		ra, rb := find(p.UserA), find(p.UserB)
+		if ra != rb {
+			parent[rb] = ra
+		}
+	}
+
+	groups := make(map[uint]*Cluster)
+	for id := range parent {
+		root := find(id)
+		if groups[root] == nil {
+			groups[root] = &Cluster{}
+		}
+		groups[root].Members = append(groups[root].Members, id)
+	}
+	for _, p := range pairs {
+		c := groups[find(p.UserA)]
+		c.Pairs = append(c.Pairs, p)
+	}
+
+	clusters := make([]Cluster, 0, len(groups))
+	for _, c := range groups {
+		sort.Slice(c.Members, func(i, j int) bool { return c.Members[i] < c.Members[j] })
+		sort.Slice(c.Pairs, func(i, j int) bool {
+			if c.Pairs[i].UserA != c.Pairs[j].UserA {
+				return c.Pairs[i].UserA < c.Pairs[j].UserA
+			}
+			return c.Pairs[i].UserB < c.Pairs[j].UserB
+		})
+		clusters = append(clusters, *c)
+	}
+	sort.Slice(clusters, func(i, j int) bool {
+		if len(clusters[i].Members) != len(clusters[j].Members) {
+			return len(clusters[i].Members) > len(clusters[j].Members)
+		}
+		return clusters[i].Members[0] < clusters[j].Members[0]
+	})
+	return clusters
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
@@ -0,0 +1,123 @@
+package collusion
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+var base = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// votesOn 生成多名用户在同一批申请上的投票，offset 为各用户相对 base 的投票时间偏移
+func votesOn(posts []uint, voteType int, offsets map[uint]time.Duration) []Vote {
+	var votes []Vote
+	for _, postID := range posts {
+		for userID, offset := range offsets {
+			votes = append(votes, Vote{UserID: userID, PostID: postID, VoteType: voteType, At: base.Add(offset)})
+		}
+	}
+	return votes
+}
+
+func concat(groups ...[]Vote) []Vote {
+	var all []Vote
+	for _, g := range groups {
+		all = append(all, g...)
+	}
+	return all
+}
+
+func TestAnalyze(t *testing.T) {
+	opts := Options{MinShared: 3, MinAgreement: 90, TimeWindow: 10 * time.Minute}
+	timedOpts := opts
+	timedOpts.MinTimingRate = 50
+
+	tests := []struct {
+		name    string
+		votes   []Vote
+		opts    Options
+		members [][]uint
+	}{
+		{
+			name:    "无投票",
+			votes:   nil,
+			opts:    opts,
+			members: [][]uint{},
+		},
+		{
+			name:    "两人一致投票形成投票圈",
+			votes:   votesOn([]uint{1, 2, 3}, 1, map[uint]time.Duration{10: 0, 11: time.Minute}),
+			opts:    opts,
+			members: [][]uint{{10, 11}},
+		},
+		{
+			name:    "共同投票数不足",
+			votes:   votesOn([]uint{1, 2}, 1, map[uint]time.Duration{10: 0, 11: 0}),
+			opts:    opts,
+			members: [][]uint{},
+		},
+		{
+			name: "投票不一致",
+			votes: concat(
+				votesOn([]uint{1, 2, 3}, 1, map[uint]time.Duration{10: 0}),
+				votesOn([]uint{1, 2, 3}, -1, map[uint]time.Duration{11: 0}),
+			),
+			opts:    opts,
+			members: [][]uint{},
+		},
+		{
+			name:    "投票时间相隔过远且要求同时投票",
+			votes:   votesOn([]uint{1, 2, 3}, 1, map[uint]time.Duration{10: 0, 11: 24 * time.Hour}),
+			opts:    timedOpts,
+			members: [][]uint{},
+		},
+		{
+			name:    "不要求同时投票时忽略时间",
+			votes:   votesOn([]uint{1, 2, 3}, 1, map[uint]time.Duration{10: 0, 11: 24 * time.Hour}),
+			opts:    opts,
+			members: [][]uint{{10, 11}},
+		},
+		{
+			name: "可疑用户对连通为群体并按人数排序",
+			votes: concat(
+				votesOn([]uint{1, 2, 3}, 1, map[uint]time.Duration{20: 0, 21: 0}),
+				votesOn([]uint{4, 5, 6}, 1, map[uint]time.Duration{10: 0, 11: 0}),
+				votesOn([]uint{7, 8, 9}, -1, map[uint]time.Duration{11: 0, 12: 0}),
+			),
+			opts:    opts,
+			members: [][]uint{{10, 11, 12}, {20, 21}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clusters := Analyze(tt.votes, tt.opts)
+			got := make([][]uint, 0, len(clusters))
+			for _, c := range clusters {
+				got = append(got, c.Members)
+			}
+			if !reflect.DeepEqual(got, tt.members) {
+				t.Errorf("members = %v, want %v", got, tt.members)
+			}
+		})
+	}
+}
+
+func TestAnalyzePairStats(t *testing.T) {
+	votes := concat(
+		votesOn([]uint{1, 2, 3}, 1, map[uint]time.Duration{10: 0, 11: time.Minute}),
+		[]Vote{
+			{UserID: 10, PostID: 4, VoteType: 1, At: base},
+			{UserID: 11, PostID: 4, VoteType: -1, At: base.Add(time.Hour)},
+		},
+	)
+	clusters := Analyze(votes, Options{MinShared: 3, MinAgreement: 70, TimeWindow: 10 * time.Minute})
+	if len(clusters) != 1 || len(clusters[0].Pairs) != 1 {
+		t.Fatalf("clusters = %+v, want exactly one pair", clusters)
+	}
+
+	want := Pair{UserA: 10, UserB: 11, Shared: 4, Agreement: 75, TimingRate: 75}
+	if got := clusters[0].Pairs[0]; got != want {
+		t.Errorf("pair = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,101 @@
+// Package markdown 将申请内容的 Markdown 渲染为经过白名单过滤的安全 HTML
+package markdown
+
+import (
+	"bytes"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// Options 渲染选项
+type Options struct {
+	NofollowLinks bool   // 为链接添加 rel="nofollow"
+	ImageProxyURL string // 图片代理地址前缀(原图地址经URL编码后拼接在末尾)，为空表示不代理
+}
+
+var (
+	// 不启用 html.WithUnsafe，原始 HTML 在渲染阶段即被丢弃
+	defaultMarkdown = newMarkdown("")
+
+	policyFollow   = newPolicy(false)
+	policyNofollow = newPolicy(true)
+)
+
+// Render 渲染 Markdown 并过滤为安全 HTML
+func Render(source string, opts Options) string {
+	md := defaultMarkdown
+	if opts.ImageProxyURL != "" {
+		md = newMarkdown(opts.ImageProxyURL)
+	}
+
+	var buf bytes.Buffer
+	if err := md.Convert([]byte(source), &buf); err != nil {
+		return ""
+	}
+
+	policy := policyFollow
+	if opts.NofollowLinks {
+		policy = policyNofollow
+	}
+	return policy.Sanitize(buf.String())
+}
+
+// newMarkdown 创建 Markdown 解析器(GFM 语法，保留换行)
+func newMarkdown(imageProxyURL string) goldmark.Markdown {
+	parserOptions := []parser.Option{}
+	if imageProxyURL != "" {
+		parserOptions = append(parserOptions, parser.WithASTTransformers(
+			util.Prioritized(&imageProxyTransformer{prefix: imageProxyURL}, 100),
+		))
+	}
+
+	return goldmark.New(
+		goldmark.WithExtensions(extension.GFM),
+		goldmark.WithParserOptions(parserOptions...),
+		goldmark.WithRendererOptions(html.WithHardWraps()),
+	)
+}
+
+// newPolicy 创建 HTML 白名单策略
+func newPolicy(nofollow bool) *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.RequireNoFollowOnLinks(nofollow)
+	// 代码块语言标记和 GFM 任务列表
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[\w+-]+$`)).OnElements("code")
+	p.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+	p.AllowAttrs("checked", "disabled").OnElements("input")
+	return p
+}
+
+// imageProxyTransformer 将外部图片地址改写为代理地址
+type imageProxyTransformer struct {
+	prefix string
+}
+
+// Transform 实现 parser.ASTTransformer
+func (t *imageProxyTransformer) Transform(doc *ast.Document, reader text.Reader, pc parser.Context) {
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		img, ok := n.(*ast.Image)
+		if !ok {
+			return ast.WalkContinue, nil
+		}
+		dest := string(img.Destination)
+		if strings.HasPrefix(dest, "http://") || strings.HasPrefix(dest, "https://") {
+			img.Destination = []byte(t.prefix + url.QueryEscape(dest))
+		}
+		return ast.WalkContinue, nil
+	})
+}
@@ -0,0 +1,79 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name       string
+		source     string
+		opts       Options
+		contains   []string
+		notContain []string
+	}{
+		{
+			name:       "过滤script标签",
+			source:     "正文\n\n<script>alert(1)</script>",
+			contains:   []string{"正文"},
+			notContain: []string{"<script", "alert(1)"},
+		},
+		{
+			name:       "过滤内联事件属性",
+			source:     `<img src="x" onerror="alert(1)">`,
+			notContain: []string{"onerror"},
+		},
+		{
+			name:       "移除javascript链接",
+			source:     "[点我](javascript:alert(1))",
+			contains:   []string{"点我"},
+			notContain: []string{"javascript:"},
+		},
+		{
+			name:     "保留普通链接",
+			source:   "[主页](https://linux.do)",
+			contains: []string{`href="https://linux.do"`},
+		},
+		{
+			name:     "链接添加nofollow",
+			source:   "[主页](https://linux.do)",
+			opts:     Options{NofollowLinks: true},
+			contains: []string{`rel="nofollow`},
+		},
+		{
+			name:       "外部图片走代理",
+			source:     "![图](https://example.com/a.png?x=1)",
+			opts:       Options{ImageProxyURL: "https://proxy.local/img?url="},
+			contains:   []string{`src="https://proxy.local/img?url=https%3A%2F%2Fexample.com%2Fa.png%3Fx%3D1"`},
+			notContain: []string{`src="https://example.com`},
+		},
+		{
+			name:     "未配置代理时保留原图地址",
+			source:   "![图](https://example.com/a.png)",
+			contains: []string{`src="https://example.com/a.png"`},
+		},
+		{
+			name:     "相对地址图片不走代理",
+			source:   "![图](/static/a.png)",
+			opts:     Options{ImageProxyURL: "https://proxy.local/img?url="},
+			contains: []string{`src="/static/a.png"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Render(tt.source, tt.opts)
+			for _, s := range tt.contains {
+				if !strings.Contains(got, s) {
+					t.Errorf("Render(%q) = %q, want contains %q", tt.source, got, s)
+				}
+			}
+			for _, s := range tt.notContain {
+				if strings.Contains(got, s) {
+					t.Errorf("Render(%q) = %q, want not contains %q", tt.source, got, s)
+				}
+			}
+		})
+	}
+}
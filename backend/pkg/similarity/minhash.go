@@ -0,0 +1,128 @@
+// Package similarity 使用 shingling + MinHash 估算文本之间的相似度
+package similarity
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"strings"
+	"unicode"
+)
+
+const (
+	// ShingleSize 每个 shingle 包含的字符数
+	ShingleSize = 5
+	// NumHashes MinHash 签名长度，越长估算越精确
+	NumHashes = 128
+)
+
+// seeds 每个哈希函数使用的种子(由 splitmix64 生成，保证各版本签名一致)
+var seeds = func() [NumHashes]uint64 {
+	var s [NumHashes]uint64
+	x := uint64(0x9e3779b97f4a7c15)
+	for i := range s {
+		x = mix(x + uint64(i))
+		s[i] = x
+	}
+	return s
+}()
+
+// Signature MinHash 签名
+type Signature []uint32
+
+// Normalize 规范化文本：转小写，只保留字母和数字，忽略空白和标点带来的差异
+func Normalize(text string) []rune {
+	runes := make([]rune, 0, len(text))
+	for _, r := range strings.ToLower(text) {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) {
+			runes = append(runes, r)
+		}
+	}
+	return runes
+}
+
+// Shingles 将文本切分为去重后的 shingle 哈希集合
+func Shingles(text string) map[uint64]struct{} {
+	runes := Normalize(text)
+	set := make(map[uint64]struct{})
+	if len(runes) == 0 {
+		return set
+	}
+	if len(runes) < ShingleSize {
+		set[hashString(string(runes))] = struct{}{}
+		return set
+	}
+	for i := 0; i+ShingleSize <= len(runes); i++ {
+		set[hashString(string(runes[i:i+ShingleSize]))] = struct{}{}
+	}
+	return set
+}
+
+// Compute 计算文本的 MinHash 签名，文本为空时返回 nil
+func Compute(text string) Signature {
+	shingles := Shingles(text)
+	if len(shingles) == 0 {
+		return nil
+	}
+
+	sig := make(Signature, NumHashes)
+	for i := range sig {
+		sig[i] = ^uint32(0)
+	}
+	for h := range shingles {
+		for i, seed := range seeds {
+			if v := uint32(mix(h ^ seed)); v < sig[i] {
+				sig[i] = v
+			}
+		}
+	}
+	return sig
+}
+
+// Similarity 根据两个签名估算 Jaccard 相似度(0-1)
+func Similarity(a, b Signature) float64 {
+	if len(a) != NumHashes || len(b) != NumHashes {
+		return 0
+	}
+	same := 0
+	for i := range a {
+		if a[i] == b[i] {
+			same++
+		}
+	}
+	return float64(same) / float64(NumHashes)
+}
+
+// Encode 将签名编码为字节(用于存储)
+func (s Signature) Encode() []byte {
+	buf := make([]byte, len(s)*4)
+	for i, v := range s {
+		binary.LittleEndian.PutUint32(buf[i*4:], v)
+	}
+	return buf
+}
+
+// Decode 从字节解码签名
+func Decode(buf []byte) Signature {
+	if len(buf)%4 != 0 {
+		return nil
+	}
+	sig := make(Signature, len(buf)/4)
+	for i := range sig {
+		sig[i] = binary.LittleEndian.Uint32(buf[i*4:])
+	}
+	return sig
+}
+
+func hashString(s string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(s))
+	return h.Sum64()
+}
+
+// mix splitmix64 混合函数
+func mix(x uint64) uint64 {
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	return x ^ (x >> 31)
+}
@@ -0,0 +1,96 @@
+package similarity
+
+import (
+	"reflect"
+	"testing"
+)
+
+const (
+	sampleA = "我是一名后端开发者，平时主要使用 Go 语言编写服务，希望加入社区与大家交流分布式系统和数据库的经验。"
+	sampleB = "我是一名后端开发者，平时主要使用 Go 语言编写服务，希望加入社区与大家交流分布式系统和缓存设计的经验。"
+	sampleC = "Frontend designer here: I love CSS animations, typography and accessible color palettes for the web."
+)
+
+func TestSimilarity(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		min  float64
+		max  float64
+	}{
+		{"完全相同", sampleA, sampleA, 1, 1},
+		{"忽略大小写和标点", "Hello, World! Linux Do", "hello world linux do", 1, 1},
+		{"少量改动", sampleA, sampleB, 0.5, 0.99},
+		{"完全不同", sampleA, sampleC, 0, 0.1},
+		{"短文本相同", "abc", "abc", 1, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Similarity(Compute(tt.a), Compute(tt.b))
+			if got < 0 || got > 1 {
+				t.Fatalf("Similarity = %v, out of [0, 1]", got)
+			}
+			if got < tt.min || got > tt.max {
+				t.Errorf("Similarity = %v, want in [%v, %v]", got, tt.min, tt.max)
+			}
+			if rev := Similarity(Compute(tt.b), Compute(tt.a)); rev != got {
+				t.Errorf("Similarity not symmetric: %v vs %v", got, rev)
+			}
+		})
+	}
+}
+
+func TestSimilarityInvalidSignature(t *testing.T) {
+	sig := Compute(sampleA)
+	tests := []struct {
+		name string
+		a, b Signature
+	}{
+		{"空签名", nil, nil},
+		{"一侧为空", sig, nil},
+		{"长度不符", sig, sig[:NumHashes/2]},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Similarity(tt.a, tt.b); got != 0 {
+				t.Errorf("Similarity = %v, want 0", got)
+			}
+		})
+	}
+}
+
+func TestCompute(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		wantNil bool
+	}{
+		{"空文本", "", true},
+		{"只有标点和空白", "，。！ \n\t", true},
+		{"普通文本", sampleA, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sig := Compute(tt.text)
+			if (sig == nil) != tt.wantNil {
+				t.Fatalf("Compute(%q) nil = %v, want %v", tt.text, sig == nil, tt.wantNil)
+			}
+			if !tt.wantNil && len(sig) != NumHashes {
+				t.Errorf("len(sig) = %d, want %d", len(sig), NumHashes)
+			}
+		})
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	sig := Compute(sampleA)
+	if got := Decode(sig.Encode()); !reflect.DeepEqual(got, sig) {
+		t.Errorf("Decode(Encode(sig)) mismatch")
+	}
+	if got := Decode([]byte{1, 2, 3}); got != nil {
+		t.Errorf("Decode(invalid) = %v, want nil", got)
+	}
+}
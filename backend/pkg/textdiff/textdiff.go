@@ -0,0 +1,116 @@
+package textdiff
+
+import "strings"
+
+// OpType 差异操作类型
+type OpType string
+
+const (
+	OpEqual  OpType = "equal"  // 未变化
+	OpInsert OpType = "insert" // 新增
+	OpDelete OpType = "delete" // 删除
+)
+
+// Line 差异行
+type Line struct {
+	Op   OpType `json:"op"`
+	Text string `json:"text"`
+}
+
+// maxLines 参与LCS计算的最大行数(超出时退化为整体替换，避免内存占用过大)
+const maxLines = 2000
+
+// Lines 按行比较两段文本，返回差异行列表
+func Lines(oldText, newText string) []Line {
+	a := splitLines(oldText)
+	b := splitLines(newText)
+
+	if len(a) > maxLines || len(b) > maxLines {
+		result := make([]Line, 0, len(a)+len(b))
+		for _, l := range a {
+			result = append(result, Line{Op: OpDelete, Text: l})
+		}
+		for _, l := range b {
+			result = append(result, Line{Op: OpInsert, Text: l})
+		}
+		return result
+	}
+
+	// lcs[i][j] 为 a[i:] 与 b[j:] 的最长公共子序列长度
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	result := make([]Line, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			result = append(result, Line{Op: OpEqual, Text: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			result = append(result, Line{Op: OpDelete, Text: a[i]})
+			i++
+		default:
+			result = append(result, Line{Op: OpInsert, Text: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		result = append(result, Line{Op: OpDelete, Text: a[i]})
+	}
+	for ; j < len(b); j++ {
+		result = append(result, Line{Op: OpInsert, Text: b[j]})
+	}
+
+	return result
+}
+
+// ChangeRate 计算两段文本的变化比例(百分比)，以变动行的字符数相对于较长文本的字符数计算
+func ChangeRate(oldText, newText string) float64 {
+	total := len([]rune(oldText))
+	if n := len([]rune(newText)); n > total {
+		total = n
+	}
+	if total == 0 {
+		return 0
+	}
+
+	// 修改一行会同时产生删除和新增，取两者中较大的作为变动量
+	deleted, inserted := 0, 0
+	for _, l := range Lines(oldText, newText) {
+		switch l.Op {
+		case OpDelete:
+			deleted += len([]rune(l.Text))
+		case OpInsert:
+			inserted += len([]rune(l.Text))
+		}
+	}
+	changed := deleted
+	if inserted > changed {
+		changed = inserted
+	}
+
+	return float64(changed) / float64(total) * 100
+}
+
+// splitLines 按换行符拆分文本(统一处理\r\n)
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+}
@@ -0,0 +1,66 @@
+package textdiff
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestChangeRate(t *testing.T) {
+	tests := []struct {
+		name    string
+		oldText string
+		newText string
+		want    float64
+	}{
+		{"都为空", "", "", 0},
+		{"原文为空", "", "abc", 100},
+		{"新文为空", "abc", "", 100},
+		{"完全相同", "第一行\n第二行", "第一行\n第二行", 0},
+		{"整体替换", "abc", "xyz", 100},
+		{"追加一行", "ab", "ab\ncd", 40},
+		{"中文按字符计算", "你好\n世界", "你好\n天地", 40},
+		{"统一处理CRLF", "a\r\nb", "a\nb", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ChangeRate(tt.oldText, tt.newText)
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("ChangeRate(%q, %q) = %v, want %v", tt.oldText, tt.newText, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name    string
+		oldText string
+		newText string
+		want    []Line
+	}{
+		{"都为空", "", "", []Line{}},
+		{
+			"修改中间一行",
+			"a\nb\nc",
+			"a\nx\nc",
+			[]Line{{OpEqual, "a"}, {OpDelete, "b"}, {OpInsert, "x"}, {OpEqual, "c"}},
+		},
+		{
+			"新增和删除",
+			"a\nb",
+			"b\nc",
+			[]Line{{OpDelete, "a"}, {OpEqual, "b"}, {OpInsert, "c"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Lines(tt.oldText, tt.newText)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Lines(%q, %q) = %v, want %v", tt.oldText, tt.newText, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"time"
+
+	"linuxdo-review/models"
+
+	"gorm.io/gorm"
+)
+
+// AppealRepository 申诉仓库
+type AppealRepository struct {
+	db *gorm.DB
+}
+
+// NewAppealRepository 创建申诉仓库
+func NewAppealRepository(db *gorm.DB) *AppealRepository {
+	return &AppealRepository{db: db}
+}
+
+// WithTx 返回使用指定事务的申诉仓库
+func (r *AppealRepository) WithTx(tx *gorm.DB) *AppealRepository {
+	return &AppealRepository{db: tx}
+}
+
+// Create 创建申诉
+func (r *AppealRepository) Create(appeal *models.Appeal) error {
+	return r.db.Create(appeal).Error
+}
+
+// FindByID 根据ID查找申诉(包含帖子、申请者和处理人)
+func (r *AppealRepository) FindByID(id uint) (*models.Appeal, error) {
+	var appeal models.Appeal
+	err := r.db.Preload("Post").Preload("Post.User").Preload("User").Preload("Handler").First(&appeal, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &appeal, nil
+}
+
+// FindByPostID 根据帖子ID查找申诉
+func (r *AppealRepository) FindByPostID(postID uint) (*models.Appeal, error) {
+	var appeal models.Appeal
+	err := r.db.Preload("Handler").Where("post_id = ?", postID).First(&appeal).Error
+	if err != nil {
+		return nil, err
+	}
+	return &appeal, nil
+}
+
+// List 获取申诉列表(分页)，status 为 nil 时返回所有状态
+func (r *AppealRepository) List(status *int, offset, limit int) ([]*models.Appeal, int64, error) {
+	var appeals []*models.Appeal
+	var total int64
+
+	query := r.db.Model(&models.Appeal{})
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 待处理的申诉按提交时间先后排列，其余按最新排列
+	order := "created_at DESC"
+	if status != nil && models.AppealStatus(*status) == models.AppealPending {
+		order = "created_at ASC"
+	}
+
+	if err := query.Preload("Post").Preload("User").Preload("Handler").
+		Order(order).Offset(offset).Limit(limit).Find(&appeals).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return appeals, total, nil
+}
+
+// Decide 处理申诉(只有待处理的申诉可以被处理)
+func (r *AppealRepository) Decide(id uint, status models.AppealStatus, handlerID uint, note string, reopenedTo *models.PostStatus) error {
+	result := r.db.Model(&models.Appeal{}).
+		Where("id = ? AND status = ?", id, models.AppealPending).
+		Updates(map[string]interface{}{
+			"status":        status,
+			"handler_id":    handlerID,
+			"decision_note": note,
+			"reopened_to":   reopenedTo,
+			"decided_at":    time.Now(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// CountByStatus 统计指定状态的申诉数量
+func (r *AppealRepository) CountByStatus(status models.AppealStatus) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Appeal{}).Where("status = ?", status).Count(&count).Error
+	return count, err
+}
+
+// CreateNote 创建申诉内部备注
+func (r *AppealRepository) CreateNote(note *models.AppealNote) error {
+	return r.db.Create(note).Error
+}
+
+// ListNotes 获取申诉的内部备注(按时间升序)
+func (r *AppealRepository) ListNotes(appealID uint) ([]*models.AppealNote, error) {
+	var notes []*models.AppealNote
+	err := r.db.Preload("User").Where("appeal_id = ?", appealID).Order("created_at ASC").Find(&notes).Error
+	return notes, err
+}
@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"time"
+
+	"linuxdo-review/models"
+
+	"gorm.io/gorm"
+)
+
+// CommentRepository 评论仓库
+type CommentRepository struct {
+	db *gorm.DB
+}
+
+// NewCommentRepository 创建评论仓库
+func NewCommentRepository(db *gorm.DB) *CommentRepository {
+	return &CommentRepository{db: db}
+}
+
+// Create 创建评论
+func (r *CommentRepository) Create(comment *models.Comment) error {
+	return r.db.Create(comment).Error
+}
+
+// FindByID 根据ID查找评论
+func (r *CommentRepository) FindByID(id uint) (*models.Comment, error) {
+	var comment models.Comment
+	err := r.db.Preload("User").First(&comment, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+// ListRoots 分页获取帖子的顶层评论(按时间升序)
+func (r *CommentRepository) ListRoots(postID uint, offset, limit int) ([]*models.Comment, int64, error) {
+	var comments []*models.Comment
+	var total int64
+
+	query := r.db.Model(&models.Comment{}).Where("post_id = ? AND parent_id IS NULL", postID)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.Preload("User").Order("created_at ASC, id ASC").
+		Offset(offset).Limit(limit).Find(&comments).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return comments, total, nil
+}
+
+// ListByRootIDs 获取指定顶层评论下的全部回复(按时间升序)
+func (r *CommentRepository) ListByRootIDs(rootIDs []uint) ([]*models.Comment, error) {
+	var comments []*models.Comment
+	if len(rootIDs) == 0 {
+		return comments, nil
+	}
+	err := r.db.Preload("User").Where("root_id IN ?", rootIDs).Order("created_at ASC, id ASC").Find(&comments).Error
+	return comments, err
+}
+
+// UpdateContent 修改评论内容
+func (r *CommentRepository) UpdateContent(id uint, content string) error {
+	return r.db.Model(&models.Comment{}).Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"content":   content,
+			"edited_at": time.Now(),
+		}).Error
+}
+
+// SetHidden 设置评论隐藏状态
+func (r *CommentRepository) SetHidden(id uint, hidden bool, operatorID uint) error {
+	updates := map[string]interface{}{
+		"hidden":    hidden,
+		"hidden_by": nil,
+	}
+	if hidden {
+		updates["hidden_by"] = operatorID
+	}
+	return r.db.Model(&models.Comment{}).Where("id = ?", id).Updates(updates).Error
+}
+
+// MarkDeleted 标记评论为已删除(保留占位，清空内容)
+func (r *CommentRepository) MarkDeleted(id uint, operatorID uint) error {
+	return r.db.Model(&models.Comment{}).Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"deleted":    true,
+			"deleted_by": operatorID,
+			"content":    "",
+		}).Error
+}
+
+// CountVisibleByPost 统计帖子的可见评论数
+func (r *CommentRepository) CountVisibleByPost(postID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Comment{}).
+		Where("post_id = ? AND hidden = ? AND deleted = ?", postID, false, false).
+		Count(&count).Error
+	return count, err
+}
@@ -1,6 +1,9 @@
 package repository
 
 import (
+	"strconv"
+	"strings"
+
 	"linuxdo-review/models"
 
 	"gorm.io/gorm"
@@ -26,6 +29,59 @@ func (r *ConfigRepository) Get(key string) (string, error) {
 	return config.Value, nil
 }
 
+// GetInt 获取整数配置值(不存在或无法解析时返回默认值)
+func (r *ConfigRepository) GetInt(key string, defaultValue int) int {
+	val, err := r.Get(key)
+	if err != nil {
+		return defaultValue
+	}
+	config := &models.SystemConfig{Key: key, Value: val}
+	return config.GetIntValue(defaultValue)
+}
+
+// GetBool 获取布尔配置值(不存在或无法解析时返回默认值)
+func (r *ConfigRepository) GetBool(key string, defaultValue bool) bool {
+	val, err := r.Get(key)
+	if err != nil {
+		return defaultValue
+	}
+	b, err := strconv.ParseBool(strings.TrimSpace(val))
+	if err != nil {
+		return defaultValue
+	}
+	return b
+}
+
+// GetList 获取逗号分隔的列表配置值(去除空白项)
+func (r *ConfigRepository) GetList(key string, defaultValue string) []string {
+	var list []string
+	for _, item := range strings.Split(r.GetString(key, defaultValue), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// GetFloat 获取浮点数配置值(不存在或无法解析时返回默认值)
+func (r *ConfigRepository) GetFloat(key string, defaultValue float64) float64 {
+	val, err := r.Get(key)
+	if err != nil {
+		return defaultValue
+	}
+	config := &models.SystemConfig{Key: key, Value: val}
+	return config.GetFloatValue(defaultValue)
+}
+
+// GetString 获取字符串配置值(不存在或为空时返回默认值)
+func (r *ConfigRepository) GetString(key string, defaultValue string) string {
+	val, err := r.Get(key)
+	if err != nil || val == "" {
+		return defaultValue
+	}
+	return val
+}
+
 // Set 设置配置值
 func (r *ConfigRepository) Set(key, value, description string) error {
 	config := models.SystemConfig{
@@ -53,6 +109,53 @@ func (r *ConfigRepository) InitDefaults() error {
 	defaults := []models.SystemConfig{
 		{Key: "min_votes", Value: "10", Description: "进入二级审核的最小票数"},
 		{Key: "approval_rate", Value: "70", Description: "赞率阈值(百分比)"},
+		{Key: models.ConfigEditVotePolicy, Value: models.DefaultEditVotePolicy, Description: "实质性修改申请后对已有投票的处理方式(none/flag/reset)"},
+		{Key: models.ConfigEditSubstantialRate, Value: strconv.Itoa(models.DefaultEditSubstantialRate), Description: "判定为实质性修改的内容变化比例(百分比)"},
+		{Key: models.ConfigReapplyCooldownHours, Value: strconv.Itoa(models.DefaultReapplyCooldownHours), Description: "被拒绝后重新申请的基础冷却时间(小时，0表示不限制)"},
+		{Key: models.ConfigReapplyCooldownMultiplier, Value: strconv.Itoa(models.DefaultReapplyCooldownMultiplier), Description: "每多一次拒绝冷却时间的倍增系数"},
+		{Key: models.ConfigReapplyCooldownMaxHours, Value: strconv.Itoa(models.DefaultReapplyCooldownMaxHours), Description: "重新申请冷却时间上限(小时)"},
+		{Key: models.ConfigMaxApplyAttempts, Value: strconv.Itoa(models.DefaultMaxApplyAttempts), Description: "终身最多申请次数(0表示不限制，撤回的申请不计入)"},
+		{Key: models.ConfigCommentEditMinutes, Value: strconv.Itoa(models.DefaultCommentEditMinutes), Description: "评论发布后允许修改的时间窗口(分钟，0表示不允许修改)"},
+		{Key: models.ConfigCommentDeleteMinutes, Value: strconv.Itoa(models.DefaultCommentDeleteMinutes), Description: "评论发布后允许删除的时间窗口(分钟，0表示不允许删除)"},
+		{Key: models.ConfigDownvoteReasonRequired, Value: models.DefaultDownvoteReasonRequired, Description: "投反对票时是否必须选择原因分类或填写原因(true/false)"},
+		{Key: models.ConfigDownvoteReasonCategories, Value: models.DefaultDownvoteReasonCategories, Description: "反对原因分类(逗号分隔)"},
+		{Key: models.ConfigMarkdownNofollowLinks, Value: models.DefaultMarkdownNofollowLinks, Description: "渲染申请内容时为链接添加 rel=\"nofollow\"(true/false，修改后需重新渲染)"},
+		{Key: models.ConfigMarkdownImageProxyEnabled, Value: models.DefaultMarkdownImageProxyEnabled, Description: "是否通过代理加载申请内容中的外部图片(true/false，修改后需重新渲染)"},
+		{Key: models.ConfigMarkdownImageProxyURL, Value: "", Description: "图片代理地址前缀，原图地址经URL编码后拼接在末尾"},
+		{Key: models.ConfigSimilarityWarnThreshold, Value: strconv.Itoa(models.DefaultSimilarityWarnThreshold), Description: "申请与其他用户历史申请相似度超过该值时显示疑似重复警告(百分比)"},
+		{Key: models.ConfigSimilarityHoldThreshold, Value: strconv.Itoa(models.DefaultSimilarityHoldThreshold), Description: "相似度超过该值时自动暂停投票等待管理员处理(百分比，0表示不启用)"},
+		{Key: models.ConfigModerationEnabled, Value: models.DefaultModerationEnabled, Description: "新申请是否需要先由管理员或认证用户预审后再进入社区投票(true/false)"},
+		{Key: models.ConfigModerationAutoReleaseHours, Value: strconv.Itoa(models.DefaultModerationAutoReleaseHours), Description: "预审超过该时间未处理的申请自动放行到投票(小时，0表示不自动放行，被自动暂停的申请不会自动放行)"},
+		{Key: models.ConfigReportCategories, Value: models.DefaultReportCategories, Description: "举报分类(逗号分隔)"},
+		{Key: models.ConfigReportAutoHideThreshold, Value: strconv.Itoa(models.DefaultReportAutoHideThreshold), Description: "投票中的申请被多少名可信用户举报后自动隐藏等待管理员处理(0表示不启用)"},
+		{Key: models.ConfigReportTrustedLevel, Value: strconv.Itoa(models.DefaultReportTrustedLevel), Description: "举报计入自动隐藏所需的最低LinuxDo信任等级(管理员和认证用户始终计入)"},
+		{Key: models.ConfigVoteMinTrustLevel, Value: strconv.Itoa(models.DefaultVoteMinTrustLevel), Description: "投票计票所需的最低LinuxDo信任等级(不满足时投票仅记录不计票，管理员不受限制)"},
+		{Key: models.ConfigVoteMinAccountDays, Value: strconv.Itoa(models.DefaultVoteMinAccountDays), Description: "投票计票所需的最短注册天数(0表示不限制)"},
+		{Key: models.ConfigVoteMinPriorVotes, Value: strconv.Itoa(models.DefaultVoteMinPriorVotes), Description: "在其他申请上至少投过多少票后投票才计票(0表示不限制)"},
+		{Key: models.ConfigVoteRingMinShared, Value: strconv.Itoa(models.DefaultVoteRingMinShared), Description: "投票圈分析: 两名用户至少共同投过多少个申请才参与判定"},
+		{Key: models.ConfigVoteRingMinAgreement, Value: strconv.Itoa(models.DefaultVoteRingMinAgreement), Description: "投票圈分析: 共同投票的一致率阈值(百分比)"},
+		{Key: models.ConfigVoteRingWindowSeconds, Value: strconv.Itoa(models.DefaultVoteRingWindowSeconds), Description: "投票圈分析: 两次投票间隔不超过该秒数视为同时投票"},
+		{Key: models.ConfigVoteRingMinTimingRate, Value: strconv.Itoa(models.DefaultVoteRingMinTimingRate), Description: "投票圈分析: 同时投票比例阈值(百分比，0表示只看一致率)"},
+		{Key: models.ConfigReputationHalfLifeDays, Value: strconv.Itoa(models.DefaultReputationHalfLifeDays), Description: "投票者信誉的半衰期(天)，越早的投票对信誉的影响越小"},
+		{Key: models.ConfigReputationVoteWeight, Value: models.DefaultReputationVoteWeight, Description: "是否按投票者信誉加权计算赞率(true/false，票数门槛仍按人数计算)"},
+		{Key: models.ConfigVoteMinReputation, Value: "", Description: "投票计票所需的最低信誉分(为空表示不限制，管理员不受限制)"},
+		{Key: models.ConfigBlindVoting, Value: models.DefaultBlindVoting, Description: "盲投模式: 申请处于投票阶段时只有管理员能看到票数和赞率，进入下一阶段后公开(true/false)"},
+		{Key: models.ConfigReviewRequiredApprovals, Value: strconv.Itoa(models.DefaultReviewRequiredApprovals), Description: "二级审核通过所需的审核员同意人数(大于1时为多人审核模式，由最后一名同意的审核员提交邀请码)"},
+		{Key: models.ConfigReviewVetoCount, Value: strconv.Itoa(models.DefaultReviewVetoCount), Description: "二级审核中累计多少名审核员否决即拒绝申请"},
+		{Key: models.ConfigReviewLockMinutes, Value: strconv.Itoa(models.DefaultReviewLockMinutes), Description: "审核员领取申请后的锁定时长(分钟)，审核页面通过心跳续期，超时未续期自动释放"},
+		{Key: models.ConfigReviewSkipHours, Value: strconv.Itoa(models.DefaultReviewSkipHours), Description: "审核员跳过的申请多久后重新出现在其审核队列中(小时，0表示不过期，审核员可随时取消跳过)"},
+		{Key: models.ConfigReviewPriorityWaitWeight, Value: strconv.FormatFloat(models.DefaultReviewPriorityWaitWeight, 'f', -1, 64), Description: "审核队列优先级: 在二级审核中每等待1小时增加的分数"},
+		{Key: models.ConfigReviewPriorityApprovalWeight, Value: strconv.FormatFloat(models.DefaultReviewPriorityApprovalWeight, 'f', -1, 64), Description: "审核队列优先级: 赞率每1%增加的分数"},
+		{Key: models.ConfigReviewPriorityVotesWeight, Value: strconv.FormatFloat(models.DefaultReviewPriorityVotesWeight, 'f', -1, 64), Description: "审核队列优先级: 每张计票的投票增加的分数(开启信誉加权时按权重计算)"},
+		{Key: models.ConfigReviewPriorityReputationWeight, Value: strconv.FormatFloat(models.DefaultReviewPriorityReputationWeight, 'f', -1, 64), Description: "审核队列优先级: 申请者的投票者信誉每1分增加的分数(可为负)"},
+		{Key: models.ConfigReviewConflictVoted, Value: models.DefaultReviewConflictVoted, Description: "利益冲突: 审核员在投票阶段投过票的申请不分配给该审核员且不能由其通过(true/false)"},
+		{Key: models.ConfigReviewConflictRelationship, Value: models.DefaultReviewConflictRelationship, Description: "利益冲突: 审核员与申请者有管理员登记的关系时不能审核(true/false)"},
+		{Key: models.ConfigReviewConflictSharedDevice, Value: models.DefaultReviewConflictSharedDevice, Description: "利益冲突: 审核员与申请者近期登录时使用过相同的IP或设备指纹时不能审核(true/false)"},
+		{Key: models.ConfigReviewConflictDeviceDays, Value: strconv.Itoa(models.DefaultReviewConflictDeviceDays), Description: "利益冲突: 只比较最近多少天内登录使用的设备(天)"},
+		{Key: models.ConfigReviewConflictSharedIP, Value: models.DefaultReviewConflictSharedIP, Description: "利益冲突: 近期登录使用过相同IP也视为相同设备(同一网络出口下的用户可能被误判，可关闭后只比较设备指纹)(true/false)"},
+		{Key: models.ConfigReviewSLAEscalateHours, Value: strconv.Itoa(models.DefaultReviewSLAEscalateHours), Description: "审核时限: 申请进入二级审核超过该时间未处理时升级，在审核队列中突出显示并邮件通知管理员(小时，0表示不启用)"},
+		{Key: models.ConfigReviewSLAFallbackHours, Value: strconv.Itoa(models.DefaultReviewSLAFallbackHours), Description: "审核时限: 申请进入二级审核超过该时间仍未处理时执行兜底操作(小时，0表示不启用)"},
+		{Key: models.ConfigReviewSLAFallbackAction, Value: models.DefaultReviewSLAFallbackAction, Description: "审核时限: 兜底操作(notify_certified=邮件通知所有认证用户, return_to_voting=退回社区投票, wait=继续等待)"},
 	}
 
 	for _, config := range defaults {
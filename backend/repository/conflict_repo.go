@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"linuxdo-review/models"
+
+	"gorm.io/gorm"
+)
+
+// ConflictRepository 利益冲突数据仓库(用户设备及用户关系)
+type ConflictRepository struct {
+	db *gorm.DB
+}
+
+// NewConflictRepository 创建利益冲突数据仓库
+func NewConflictRepository(db *gorm.DB) *ConflictRepository {
+	return &ConflictRepository{db: db}
+}
+
+// RecordDevice 记录用户使用的IP和设备指纹(已记录过的只更新最近使用时间)
+func (r *ConflictRepository) RecordDevice(userID uint, ip, fingerprint string) error {
+	now := time.Now()
+	var device models.UserDevice
+	err := r.db.Where("user_id = ? AND ip = ? AND fingerprint = ?", userID, ip, fingerprint).First(&device).Error
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		return r.db.Create(&models.UserDevice{UserID: userID, IP: ip, Fingerprint: fingerprint, LastSeenAt: now}).Error
+	}
+	return r.db.Model(&device).Update("last_seen_at", now).Error
+}
+
+// ListSharedDeviceUserIDs 获取候选用户中与指定用户在指定时间之后使用过相同设备指纹的用户
+// matchIP 为 true 时使用过相同IP的用户也包括在内
+func (r *ConflictRepository) ListSharedDeviceUserIDs(userID uint, candidates []uint, since time.Time, matchIP bool) ([]uint, error) {
+	var ids []uint
+	if len(candidates) == 0 {
+		return ids, nil
+	}
+
+	join := "JOIN user_devices AS b ON (a.fingerprint <> '' AND b.fingerprint = a.fingerprint)"
+	if matchIP {
+		join = "JOIN user_devices AS b ON ((a.fingerprint <> '' AND b.fingerprint = a.fingerprint) OR (a.ip <> '' AND b.ip = a.ip))"
+	}
+	err := r.db.Table("user_devices AS a").
+		Joins(join).
+		Where("a.user_id = ? AND a.last_seen_at >= ?", userID, since).
+		Where("b.user_id IN ? AND b.user_id <> ? AND b.last_seen_at >= ?", candidates, userID, since).
+		Distinct("b.user_id").
+		Pluck("b.user_id", &ids).Error
+	return ids, err
+}
+
+// ListRelatedUserIDs 获取候选用户中与指定用户有登记关系的用户
+func (r *ConflictRepository) ListRelatedUserIDs(userID uint, candidates []uint) ([]uint, error) {
+	var rels []*models.UserRelationship
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	err := r.db.Where("(user_id = ? AND related_user_id IN ?) OR (related_user_id = ? AND user_id IN ?)",
+		userID, candidates, userID, candidates).
+		Find(&rels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint, len(rels))
+	for i, rel := range rels {
+		if rel.UserID == userID {
+			ids[i] = rel.RelatedUserID
+		} else {
+			ids[i] = rel.UserID
+		}
+	}
+	return ids, nil
+}
+
+// HasRelationship 两名用户之间是否有登记的关系
+func (r *ConflictRepository) HasRelationship(userA, userB uint) (bool, error) {
+	if userA > userB {
+		userA, userB = userB, userA
+	}
+	var count int64
+	err := r.db.Model(&models.UserRelationship{}).
+		Where("user_id = ? AND related_user_id = ?", userA, userB).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// CreateRelationship 登记用户关系
+func (r *ConflictRepository) CreateRelationship(rel *models.UserRelationship) error {
+	if rel.UserID > rel.RelatedUserID {
+		rel.UserID, rel.RelatedUserID = rel.RelatedUserID, rel.UserID
+	}
+	return r.db.Create(rel).Error
+}
+
+// DeleteRelationship 删除用户关系
+func (r *ConflictRepository) DeleteRelationship(id uint) error {
+	result := r.db.Delete(&models.UserRelationship{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// ListRelationships 获取用户关系列表(分页)，userID 不为0时只返回与该用户有关的关系
+func (r *ConflictRepository) ListRelationships(userID uint, offset, limit int) ([]*models.UserRelationship, int64, error) {
+	var rels []*models.UserRelationship
+	var total int64
+
+	query := r.db.Model(&models.UserRelationship{})
+	if userID > 0 {
+		query = query.Where("user_id = ? OR related_user_id = ?", userID, userID)
+	}
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Preload("User").Preload("RelatedUser").
+		Order("created_at DESC").
+		Offset(offset).Limit(limit).
+		Find(&rels).Error
+	return rels, total, err
+}
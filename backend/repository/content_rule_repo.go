@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"linuxdo-review/models"
+
+	"gorm.io/gorm"
+)
+
+// ContentRuleRepository 内容规则仓库
+type ContentRuleRepository struct {
+	db *gorm.DB
+}
+
+// NewContentRuleRepository 创建内容规则仓库
+func NewContentRuleRepository(db *gorm.DB) *ContentRuleRepository {
+	return &ContentRuleRepository{db: db}
+}
+
+// Create 创建规则
+func (r *ContentRuleRepository) Create(rule *models.ContentRule) error {
+	return r.db.Create(rule).Error
+}
+
+// Update 更新规则
+func (r *ContentRuleRepository) Update(rule *models.ContentRule) error {
+	return r.db.Save(rule).Error
+}
+
+// Delete 删除规则
+func (r *ContentRuleRepository) Delete(id uint) error {
+	return r.db.Delete(&models.ContentRule{}, id).Error
+}
+
+// FindByID 根据ID查找规则
+func (r *ContentRuleRepository) FindByID(id uint) (*models.ContentRule, error) {
+	var rule models.ContentRule
+	err := r.db.First(&rule, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// List 获取所有规则
+func (r *ContentRuleRepository) List() ([]*models.ContentRule, error) {
+	var rules []*models.ContentRule
+	err := r.db.Order("id ASC").Find(&rules).Error
+	return rules, err
+}
+
+// ListEnabled 获取所有启用的规则
+func (r *ContentRuleRepository) ListEnabled() ([]*models.ContentRule, error) {
+	var rules []*models.ContentRule
+	err := r.db.Where("enabled = ?", true).Order("id ASC").Find(&rules).Error
+	return rules, err
+}
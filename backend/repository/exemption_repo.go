@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"errors"
+
+	"linuxdo-review/models"
+
+	"gorm.io/gorm"
+)
+
+// ExemptionRepository 申请限制豁免仓库
+type ExemptionRepository struct {
+	db *gorm.DB
+}
+
+// NewExemptionRepository 创建申请限制豁免仓库
+func NewExemptionRepository(db *gorm.DB) *ExemptionRepository {
+	return &ExemptionRepository{db: db}
+}
+
+// FindByUserID 根据用户ID查找豁免
+func (r *ExemptionRepository) FindByUserID(userID uint) (*models.ApplyExemption, error) {
+	var exemption models.ApplyExemption
+	err := r.db.Where("user_id = ?", userID).First(&exemption).Error
+	if err != nil {
+		return nil, err
+	}
+	return &exemption, nil
+}
+
+// Save 创建或更新用户的豁免(每个用户只保留一条)
+func (r *ExemptionRepository) Save(exemption *models.ApplyExemption) error {
+	existing, err := r.FindByUserID(exemption.UserID)
+	if err == nil {
+		exemption.ID = existing.ID
+		exemption.CreatedAt = existing.CreatedAt
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	return r.db.Save(exemption).Error
+}
+
+// DeleteByUserID 删除用户的豁免
+func (r *ExemptionRepository) DeleteByUserID(userID uint) error {
+	return r.db.Where("user_id = ?", userID).Delete(&models.ApplyExemption{}).Error
+}
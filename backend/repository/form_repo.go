@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"errors"
+
+	"linuxdo-review/models"
+
+	"gorm.io/gorm"
+)
+
+// FormRepository 申请表仓库
+type FormRepository struct {
+	db *gorm.DB
+}
+
+// NewFormRepository 创建申请表仓库
+func NewFormRepository(db *gorm.DB) *FormRepository {
+	return &FormRepository{db: db}
+}
+
+// Create 创建申请表版本
+func (r *FormRepository) Create(form *models.ApplicationForm) error {
+	return r.db.Create(form).Error
+}
+
+// FindLatest 获取最新版本的申请表(没有任何版本时返回nil)
+func (r *FormRepository) FindLatest() (*models.ApplicationForm, error) {
+	var form models.ApplicationForm
+	err := r.db.Order("version DESC").First(&form).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &form, nil
+}
+
+// FindByVersion 根据版本号查找申请表
+func (r *FormRepository) FindByVersion(version int) (*models.ApplicationForm, error) {
+	var form models.ApplicationForm
+	err := r.db.Where("version = ?", version).First(&form).Error
+	if err != nil {
+		return nil, err
+	}
+	return &form, nil
+}
+
+// List 获取所有申请表版本(按版本降序)
+func (r *FormRepository) List() ([]*models.ApplicationForm, error) {
+	var forms []*models.ApplicationForm
+	err := r.db.Order("version DESC").Find(&forms).Error
+	return forms, err
+}
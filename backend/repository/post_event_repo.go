@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"linuxdo-review/models"
+
+	"gorm.io/gorm"
+)
+
+// PostEventRepository 帖子历史事件仓库
+type PostEventRepository struct {
+	db *gorm.DB
+}
+
+// NewPostEventRepository 创建帖子历史事件仓库
+func NewPostEventRepository(db *gorm.DB) *PostEventRepository {
+	return &PostEventRepository{db: db}
+}
+
+// Create 创建历史事件
+func (r *PostEventRepository) Create(event *models.PostEvent) error {
+	return r.db.Create(event).Error
+}
+
+// Record 记录历史事件(userID为0表示系统操作)
+func (r *PostEventRepository) Record(postID, userID uint, action, note string) error {
+	return r.Create(&models.PostEvent{
+		PostID: postID,
+		UserID: userID,
+		Action: action,
+		Note:   note,
+	})
+}
+
+// ListByPostID 获取帖子的历史事件(按时间升序)
+func (r *PostEventRepository) ListByPostID(postID uint) ([]*models.PostEvent, error) {
+	var events []*models.PostEvent
+	err := r.db.Preload("User").Where("post_id = ?", postID).Order("created_at ASC, id ASC").Find(&events).Error
+	return events, err
+}
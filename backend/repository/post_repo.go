@@ -8,9 +8,6 @@ import (
 	"gorm.io/gorm"
 )
 
-// 锁定超时时间（分钟）
-const LockTimeout = 5
-
 // PostRepository 帖子仓库
 type PostRepository struct {
 	db *gorm.DB
@@ -21,6 +18,16 @@ func NewPostRepository(db *gorm.DB) *PostRepository {
 	return &PostRepository{db: db}
 }
 
+// Transaction 在同一个事务中执行多个仓库的写操作(通过各仓库的 WithTx 使用事务)
+func (r *PostRepository) Transaction(fn func(tx *gorm.DB) error) error {
+	return r.db.Transaction(fn)
+}
+
+// WithTx 返回使用指定事务的帖子仓库
+func (r *PostRepository) WithTx(tx *gorm.DB) *PostRepository {
+	return &PostRepository{db: tx}
+}
+
 // Create 创建帖子
 func (r *PostRepository) Create(post *models.Post) error {
 	return r.db.Create(post).Error
@@ -46,6 +53,16 @@ func (r *PostRepository) FindByIDWithReviewer(id uint) (*models.Post, error) {
 	return &post, nil
 }
 
+// FindByIDs 根据ID批量查找帖子(包含用户)
+func (r *PostRepository) FindByIDs(ids []uint) ([]*models.Post, error) {
+	var posts []*models.Post
+	if len(ids) == 0 {
+		return posts, nil
+	}
+	err := r.db.Preload("User").Where("id IN ?", ids).Find(&posts).Error
+	return posts, err
+}
+
 // Update 更新帖子
 func (r *PostRepository) Update(post *models.Post) error {
 	return r.db.Save(post).Error
@@ -92,9 +109,57 @@ func (r *PostRepository) ListForFirstReview(offset, limit int) ([]*models.Post,
 	return r.ListByStatus(models.StatusFirstReview, offset, limit)
 }
 
-// ListForSecondReview 获取二级审核列表(等待提交邀请码)
+// ListForSecondReview 获取二级审核列表(等待提交邀请码，超过时限被升级的排在前面)
 func (r *PostRepository) ListForSecondReview(offset, limit int) ([]*models.Post, int64, error) {
-	return r.ListByStatus(models.StatusSecondReview, offset, limit)
+	var posts []*models.Post
+	var total int64
+
+	query := r.db.Model(&models.Post{}).Where("status = ?", models.StatusSecondReview)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.Preload("User").Order("escalated_at IS NULL, created_at DESC").Offset(offset).Limit(limit).Find(&posts).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return posts, total, nil
+}
+
+// UpdateContent 更新帖子标题和内容(记录修改次数和时间)
+func (r *PostRepository) UpdateContent(postID uint, title, content, contentHTML string) error {
+	return r.db.Model(&models.Post{}).Where("id = ?", postID).
+		Updates(map[string]interface{}{
+			"title":        title,
+			"content":      content,
+			"content_html": contentHTML,
+			"edit_count":   gorm.Expr("edit_count + 1"),
+			"edited_at":    time.Now(),
+		}).Error
+}
+
+// UpdateContentHTML 更新帖子渲染后的内容(不计入修改次数)
+func (r *PostRepository) UpdateContentHTML(postID uint, contentHTML string) error {
+	return r.db.Model(&models.Post{}).Where("id = ?", postID).
+		UpdateColumn("content_html", contentHTML).Error
+}
+
+// ListContentBatch 按ID升序分批获取帖子内容(用于重新渲染)
+func (r *PostRepository) ListContentBatch(afterID uint, limit int, onlyMissing bool) ([]*models.Post, error) {
+	var posts []*models.Post
+	query := r.db.Select("id", "content").Where("id > ?", afterID)
+	if onlyMissing {
+		query = query.Where("content_html = '' OR content_html IS NULL")
+	}
+	err := query.Order("id ASC").Limit(limit).Find(&posts).Error
+	return posts, err
+}
+
+// UpdateAnswers 更新帖子的申请表回答
+func (r *PostRepository) UpdateAnswers(postID uint, answers []models.PostAnswer) error {
+	return r.db.Model(&models.Post{ID: postID}).Select("answers").
+		Updates(&models.Post{Answers: answers}).Error
 }
 
 // UpdateVotes 更新帖子票数
@@ -106,6 +171,18 @@ func (r *PostRepository) UpdateVotes(postID uint, upVotes, downVotes int) error
 		}).Error
 }
 
+// UpdateCommentCount 更新帖子的可见评论数
+func (r *PostRepository) UpdateCommentCount(postID uint, count int64) error {
+	return r.db.Model(&models.Post{}).Where("id = ?", postID).
+		UpdateColumn("comment_count", count).Error
+}
+
+// SetCommentsLocked 锁定或解锁帖子评论区
+func (r *PostRepository) SetCommentsLocked(postID uint, locked bool) error {
+	return r.db.Model(&models.Post{}).Where("id = ?", postID).
+		UpdateColumn("comments_locked", locked).Error
+}
+
 // UpdateStatus 更新帖子状态
 func (r *PostRepository) UpdateStatus(postID uint, status models.PostStatus) error {
 	return r.db.Model(&models.Post{}).Where("id = ?", postID).Update("status", status).Error
@@ -134,10 +211,219 @@ func (r *PostRepository) Reject(postID uint, reason string) error {
 	return r.db.Model(&models.Post{}).Where("id = ?", postID).Updates(updates).Error
 }
 
-// PromoteToSecondReview 提升到二级审核
+// Withdraw 撤回申请(同时释放审核锁定)，只有一级或二级审核中的帖子可以撤回
+func (r *PostRepository) Withdraw(postID uint) error {
+	result := r.db.Model(&models.Post{}).
+		Where("id = ? AND status IN ?", postID, []models.PostStatus{models.StatusPending, models.StatusFirstReview, models.StatusSecondReview}).
+		Updates(map[string]interface{}{
+			"status":    models.StatusWithdrawn,
+			"locked_by": nil,
+			"locked_at": nil,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// Reopen 重新开启已拒绝的申请(清除拒绝原因和审核时间)
+func (r *PostRepository) Reopen(postID uint, status models.PostStatus) error {
+	updates := map[string]interface{}{
+		"status":        status,
+		"reject_reason": "",
+		"reviewed_at":   nil,
+		"locked_by":     nil,
+		"locked_at":     nil,
+	}
+	if status == models.StatusSecondReview {
+		updates["second_review_at"] = time.Now()
+		updates["escalated_at"] = nil
+		updates["sla_fallback_at"] = nil
+		updates["sla_fallback_action"] = ""
+	}
+	result := r.db.Model(&models.Post{}).
+		Where("id = ? AND status = ?", postID, models.StatusRejected).
+		Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// UpdateSimilarity 更新帖子的最高相似度和疑似重复标记
+func (r *PostRepository) UpdateSimilarity(postID uint, score float64, possibleDuplicate bool) error {
+	return r.db.Model(&models.Post{}).Where("id = ?", postID).
+		UpdateColumns(map[string]interface{}{
+			"similarity_score":   score,
+			"possible_duplicate": possibleDuplicate,
+		}).Error
+}
+
+// Hold 将投票中的帖子暂停为待审核状态并记录原因
+func (r *PostRepository) Hold(postID uint, reason string) error {
+	result := r.db.Model(&models.Post{}).
+		Where("id = ? AND status = ?", postID, models.StatusFirstReview).
+		Updates(map[string]interface{}{
+			"status":      models.StatusPending,
+			"held_at":     time.Now(),
+			"hold_reason": reason,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// Release 将待审核的帖子放行到社区投票
+func (r *PostRepository) Release(postID uint) error {
+	result := r.db.Model(&models.Post{}).
+		Where("id = ? AND status = ?", postID, models.StatusPending).
+		Updates(map[string]interface{}{
+			"status":      models.StatusFirstReview,
+			"held_at":     nil,
+			"hold_reason": "",
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// ListPendingQueue 分页获取预审队列(按进入待审核的时间升序)
+func (r *PostRepository) ListPendingQueue(offset, limit int) ([]*models.Post, int64, error) {
+	var posts []*models.Post
+	var total int64
+
+	query := r.db.Model(&models.Post{}).Where("status = ?", models.StatusPending)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.Preload("User").Order("held_at ASC, created_at ASC").Offset(offset).Limit(limit).Find(&posts).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return posts, total, nil
+}
+
+// ListExpiredPending 获取超过指定时间仍在常规预审中的帖子ID(不包括被自动暂停的帖子)
+func (r *PostRepository) ListExpiredPending(before time.Time) ([]uint, error) {
+	var ids []uint
+	err := r.db.Model(&models.Post{}).
+		Where("status = ? AND hold_reason = '' AND held_at < ?", models.StatusPending, before).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+// CountHeld 统计被自动暂停的帖子数量
+func (r *PostRepository) CountHeld() (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Post{}).
+		Where("status = ? AND hold_reason <> ''", models.StatusPending).
+		Count(&count).Error
+	return count, err
+}
+
+// PromoteToSecondReview 提升到二级审核(记录进入二级审核的时间，重新开始计算审核时限)
 func (r *PostRepository) PromoteToSecondReview(postID uint) error {
 	return r.db.Model(&models.Post{}).Where("id = ?", postID).
-		Update("status", models.StatusSecondReview).Error
+		Updates(map[string]interface{}{
+			"status":              models.StatusSecondReview,
+			"second_review_at":    time.Now(),
+			"escalated_at":        nil,
+			"sla_fallback_at":     nil,
+			"sla_fallback_action": "",
+		}).Error
+}
+
+// ListOverdueForEscalation 获取在二级审核中超过指定时间且本轮尚未升级的帖子
+func (r *PostRepository) ListOverdueForEscalation(before time.Time) ([]*models.Post, error) {
+	var posts []*models.Post
+	err := r.db.Preload("User").
+		Where("status = ? AND escalated_at IS NULL AND COALESCE(second_review_at, created_at) < ?", models.StatusSecondReview, before).
+		Order("id ASC").
+		Find(&posts).Error
+	return posts, err
+}
+
+// ListOverdueForFallback 获取在二级审核中超过指定时间且本轮尚未执行兜底操作的帖子
+func (r *PostRepository) ListOverdueForFallback(before time.Time) ([]*models.Post, error) {
+	var posts []*models.Post
+	err := r.db.Preload("User").
+		Where("status = ? AND sla_fallback_at IS NULL AND COALESCE(second_review_at, created_at) < ?", models.StatusSecondReview, before).
+		Order("id ASC").
+		Find(&posts).Error
+	return posts, err
+}
+
+// MarkEscalated 标记二级审核中的帖子已升级(已标记过时返回 gorm.ErrRecordNotFound)
+func (r *PostRepository) MarkEscalated(postID uint) error {
+	result := r.db.Model(&models.Post{}).
+		Where("id = ? AND status = ? AND escalated_at IS NULL", postID, models.StatusSecondReview).
+		UpdateColumns(map[string]interface{}{
+			"escalated_at":     time.Now(),
+			"escalation_count": gorm.Expr("escalation_count + 1"),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// MarkSLAFallback 记录二级审核中的帖子已执行兜底操作，退回投票时同时更新状态并释放锁定(票数由调用方在同一事务中清空)
+// (已执行过时返回 gorm.ErrRecordNotFound)
+func (r *PostRepository) MarkSLAFallback(postID uint, action string) error {
+	now := time.Now()
+	updates := map[string]interface{}{
+		"sla_fallback_at":     now,
+		"sla_fallback_action": action,
+	}
+	if action == models.SLAFallbackReturn {
+		updates["status"] = models.StatusFirstReview
+		updates["locked_by"] = nil
+		updates["locked_at"] = nil
+	}
+	// 兜底时限早于升级时限时一并记录升级时间
+	updates["escalated_at"] = gorm.Expr("COALESCE(escalated_at, ?)", now)
+
+	result := r.db.Model(&models.Post{}).
+		Where("id = ? AND status = ? AND sla_fallback_at IS NULL", postID, models.StatusSecondReview).
+		UpdateColumns(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// SetPriorityBoost 设置申请在审核队列中的优先级加成
+func (r *PostRepository) SetPriorityBoost(postID uint, boost float64) error {
+	result := r.db.Model(&models.Post{}).Where("id = ?", postID).UpdateColumn("priority_boost", boost)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
 }
 
 // CountByStatus 统计指定状态的帖子数量
@@ -186,6 +472,9 @@ func (r *PostRepository) ListWithFilter(status *int, offset, limit int) ([]*mode
 		} else {
 			query = query.Where("status = ?", *status)
 		}
+	} else {
+		// 待审核的帖子尚未公开，只能通过预审队列或明确指定状态查看
+		query = query.Where("status <> ?", models.StatusPending)
 	}
 
 	if err := query.Count(&total).Error; err != nil {
@@ -221,11 +510,41 @@ func (r *PostRepository) HasPendingOrVotingPost(userID uint) (bool, error) {
 	return count > 0, err
 }
 
-// GetNextForReview 获取下一个待二级审核的帖子（排除已锁定或过期锁定的）
-func (r *PostRepository) GetNextForReview(userID uint, skipIDs []uint) (*models.Post, error) {
+// CountByUserAndStatus 统计用户指定状态的帖子数量
+func (r *PostRepository) CountByUserAndStatus(userID uint, status models.PostStatus) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Post{}).
+		Where("user_id = ? AND status = ?", userID, status).
+		Count(&count).Error
+	return count, err
+}
+
+// CountAttemptsByUser 统计用户的申请次数(撤回的申请不计入)
+func (r *PostRepository) CountAttemptsByUser(userID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Post{}).
+		Where("user_id = ? AND status != ?", userID, models.StatusWithdrawn).
+		Count(&count).Error
+	return count, err
+}
+
+// FindLatestRejected 获取用户最近一次被拒绝的帖子
+func (r *PostRepository) FindLatestRejected(userID uint) (*models.Post, error) {
 	var post models.Post
+	err := r.db.Where("user_id = ? AND status = ?", userID, models.StatusRejected).
+		Order("COALESCE(reviewed_at, updated_at) DESC").
+		First(&post).Error
+	if err != nil {
+		return nil, err
+	}
+	return &post, nil
+}
+
+// ListReviewCandidates 获取可分配给审核员的待二级审核帖子（排除被他人锁定且未过期的），由调用方按优先级排序
+func (r *PostRepository) ListReviewCandidates(userID uint, skipIDs []uint, lockTimeout time.Duration) ([]*models.Post, error) {
+	var posts []*models.Post
 	now := time.Now()
-	lockExpiry := now.Add(-LockTimeout * time.Minute)
+	lockExpiry := now.Add(-lockTimeout)
 
 	query := r.db.Preload("User").
 		Where("status = ?", models.StatusSecondReview).
@@ -236,17 +555,14 @@ func (r *PostRepository) GetNextForReview(userID uint, skipIDs []uint) (*models.
 		query = query.Where("id NOT IN ?", skipIDs)
 	}
 
-	err := query.Order("created_at ASC").First(&post).Error
-	if err != nil {
-		return nil, err
-	}
-	return &post, nil
+	err := query.Order("created_at ASC").Find(&posts).Error
+	return posts, err
 }
 
 // LockPost 锁定帖子（防止并发操作）
-func (r *PostRepository) LockPost(postID uint, userID uint) error {
+func (r *PostRepository) LockPost(postID uint, userID uint, lockTimeout time.Duration) error {
 	now := time.Now()
-	lockExpiry := now.Add(-LockTimeout * time.Minute)
+	lockExpiry := now.Add(-lockTimeout)
 
 	// 只有未锁定或锁定过期或被同一用户锁定的帖子才能被锁定
 	result := r.db.Model(&models.Post{}).
@@ -273,11 +589,26 @@ func (r *PostRepository) UnlockPost(postID uint, userID uint) error {
 		}).Error
 }
 
+// RenewLock 续期当前用户持有且未过期的锁定(心跳)
+func (r *PostRepository) RenewLock(postID uint, userID uint, lockTimeout time.Duration) error {
+	now := time.Now()
+	result := r.db.Model(&models.Post{}).
+		Where("id = ? AND status = ? AND locked_by = ? AND locked_at > ?", postID, models.StatusSecondReview, userID, now.Add(-lockTimeout)).
+		Update("locked_at", now)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
 // IsPostLocked 检查帖子是否被锁定（且锁定未过期，且不是当前用户锁定的）
-func (r *PostRepository) IsPostLocked(postID uint, userID uint) (bool, error) {
+func (r *PostRepository) IsPostLocked(postID uint, userID uint, lockTimeout time.Duration) (bool, error) {
 	var count int64
 	now := time.Now()
-	lockExpiry := now.Add(-LockTimeout * time.Minute)
+	lockExpiry := now.Add(-lockTimeout)
 
 	err := r.db.Model(&models.Post{}).
 		Where("id = ?", postID).
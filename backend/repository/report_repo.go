@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"time"
+
+	"linuxdo-review/models"
+
+	"gorm.io/gorm"
+)
+
+// ReportRepository 举报仓库
+type ReportRepository struct {
+	db *gorm.DB
+}
+
+// NewReportRepository 创建举报仓库
+func NewReportRepository(db *gorm.DB) *ReportRepository {
+	return &ReportRepository{db: db}
+}
+
+// Create 创建举报
+func (r *ReportRepository) Create(report *models.Report) error {
+	return r.db.Create(report).Error
+}
+
+// FindByID 根据ID查找举报(包含举报人和处理人)
+func (r *ReportRepository) FindByID(id uint) (*models.Report, error) {
+	var report models.Report
+	err := r.db.Preload("Reporter").Preload("Handler").First(&report, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+// Exists 检查用户是否已举报过该对象
+func (r *ReportRepository) Exists(reporterID uint, targetType models.ReportTargetType, targetID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.Report{}).
+		Where("reporter_id = ? AND target_type = ? AND target_id = ?", reporterID, targetType, targetID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// List 获取举报列表(分页)，status/targetType 为空时不筛选
+func (r *ReportRepository) List(status *int, targetType string, offset, limit int) ([]*models.Report, int64, error) {
+	var reports []*models.Report
+	var total int64
+
+	query := r.db.Model(&models.Report{})
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+	if targetType != "" {
+		query = query.Where("target_type = ?", targetType)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 待处理的举报按提交时间先后排列，其余按最新排列
+	order := "created_at DESC"
+	if status != nil && models.ReportStatus(*status) == models.ReportOpen {
+		order = "created_at ASC"
+	}
+
+	if err := query.Preload("Reporter").Preload("Handler").
+		Order(order).Offset(offset).Limit(limit).Find(&reports).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return reports, total, nil
+}
+
+// CloseByTarget 处理对象的所有待处理举报，返回处理数量
+func (r *ReportRepository) CloseByTarget(targetType models.ReportTargetType, targetID uint, status models.ReportStatus, handlerID uint, resolution string) (int64, error) {
+	result := r.db.Model(&models.Report{}).
+		Where("target_type = ? AND target_id = ? AND status = ?", targetType, targetID, models.ReportOpen).
+		Updates(map[string]interface{}{
+			"status":     status,
+			"handler_id": handlerID,
+			"resolution": resolution,
+			"handled_at": time.Now(),
+		})
+	return result.RowsAffected, result.Error
+}
+
+// CountOpenByTarget 统计对象的待处理举报数量
+func (r *ReportRepository) CountOpenByTarget(targetType models.ReportTargetType, targetID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Report{}).
+		Where("target_type = ? AND target_id = ? AND status = ?", targetType, targetID, models.ReportOpen).
+		Count(&count).Error
+	return count, err
+}
+
+// CountTrustedOpenByTarget 统计对象的待处理举报中来自可信用户的数量
+// 可信用户: 管理员、认证用户或信任等级不低于 minTrustLevel 的用户
+func (r *ReportRepository) CountTrustedOpenByTarget(targetType models.ReportTargetType, targetID uint, minTrustLevel int) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Report{}).
+		Joins("JOIN users ON users.id = reports.reporter_id").
+		Where("reports.target_type = ? AND reports.target_id = ? AND reports.status = ?", targetType, targetID, models.ReportOpen).
+		Where("users.role >= ? OR users.trust_level >= ?", models.RoleCertified, minTrustLevel).
+		Count(&count).Error
+	return count, err
+}
+
+// CountByStatus 统计指定状态的举报数量
+func (r *ReportRepository) CountByStatus(status models.ReportStatus) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Report{}).Where("status = ?", status).Count(&count).Error
+	return count, err
+}
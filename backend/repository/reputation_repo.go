@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"errors"
+
+	"linuxdo-review/models"
+
+	"gorm.io/gorm"
+)
+
+// ReputationRepository 投票者信誉仓库
+type ReputationRepository struct {
+	db *gorm.DB
+}
+
+// NewReputationRepository 创建投票者信誉仓库
+func NewReputationRepository(db *gorm.DB) *ReputationRepository {
+	return &ReputationRepository{db: db}
+}
+
+// Transaction 在事务中执行
+func (r *ReputationRepository) Transaction(fn func(tx *gorm.DB) error) error {
+	return r.db.Transaction(fn)
+}
+
+// WithTx 返回使用指定事务的信誉仓库
+func (r *ReputationRepository) WithTx(tx *gorm.DB) *ReputationRepository {
+	return &ReputationRepository{db: tx}
+}
+
+// FindByUserID 根据用户ID查找信誉(没有记录时返回nil)
+func (r *ReputationRepository) FindByUserID(userID uint) (*models.VoterReputation, error) {
+	var rep models.VoterReputation
+	err := r.db.Where("user_id = ?", userID).First(&rep).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &rep, nil
+}
+
+// FindByUserIDs 批量查找信誉
+func (r *ReputationRepository) FindByUserIDs(userIDs []uint) ([]*models.VoterReputation, error) {
+	var reps []*models.VoterReputation
+	if len(userIDs) == 0 {
+		return reps, nil
+	}
+	err := r.db.Where("user_id IN ?", userIDs).Find(&reps).Error
+	return reps, err
+}
+
+// Save 创建或更新信誉
+func (r *ReputationRepository) Save(rep *models.VoterReputation) error {
+	return r.db.Save(rep).Error
+}
+
+// ListWithMinSettled 获取已结算投票数不少于 minSettled 的信誉记录(包含用户)
+func (r *ReputationRepository) ListWithMinSettled(minSettled int) ([]*models.VoterReputation, error) {
+	var reps []*models.VoterReputation
+	err := r.db.Preload("User").Where("aligned + misaligned >= ?", minSettled).Find(&reps).Error
+	return reps, err
+}
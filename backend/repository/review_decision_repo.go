@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"errors"
+
+	"linuxdo-review/models"
+
+	"gorm.io/gorm"
+)
+
+// ReviewDecisionRepository 审核员意见仓库
+type ReviewDecisionRepository struct {
+	db *gorm.DB
+}
+
+// NewReviewDecisionRepository 创建审核员意见仓库
+func NewReviewDecisionRepository(db *gorm.DB) *ReviewDecisionRepository {
+	return &ReviewDecisionRepository{db: db}
+}
+
+// WithTx 返回使用指定事务的审核员意见仓库
+func (r *ReviewDecisionRepository) WithTx(tx *gorm.DB) *ReviewDecisionRepository {
+	return &ReviewDecisionRepository{db: tx}
+}
+
+// Create 创建审核意见
+func (r *ReviewDecisionRepository) Create(decision *models.ReviewDecision) error {
+	return r.db.Create(decision).Error
+}
+
+// FindOpen 查找审核员在本轮审核中对申请提交的意见(不存在时返回nil)
+func (r *ReviewDecisionRepository) FindOpen(postID, reviewerID uint) (*models.ReviewDecision, error) {
+	var decision models.ReviewDecision
+	err := r.db.Where("post_id = ? AND reviewer_id = ? AND closed = ?", postID, reviewerID, false).
+		First(&decision).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &decision, nil
+}
+
+// CountOpen 统计申请本轮审核中的同意数和否决数
+func (r *ReviewDecisionRepository) CountOpen(postID uint) (approvals, rejections int64, err error) {
+	err = r.db.Model(&models.ReviewDecision{}).
+		Where("post_id = ? AND closed = ? AND decision = ?", postID, false, models.ReviewDecisionApprove).
+		Count(&approvals).Error
+	if err != nil {
+		return
+	}
+	err = r.db.Model(&models.ReviewDecision{}).
+		Where("post_id = ? AND closed = ? AND decision = ?", postID, false, models.ReviewDecisionReject).
+		Count(&rejections).Error
+	return
+}
+
+// ListByPost 获取申请的所有审核意见(包含审核员，按时间顺序)
+func (r *ReviewDecisionRepository) ListByPost(postID uint) ([]*models.ReviewDecision, error) {
+	var decisions []*models.ReviewDecision
+	err := r.db.Preload("Reviewer").
+		Where("post_id = ?", postID).
+		Order("created_at ASC").
+		Find(&decisions).Error
+	return decisions, err
+}
+
+// ListOpenPostIDs 获取审核员在本轮审核中已提交意见的申请ID
+func (r *ReviewDecisionRepository) ListOpenPostIDs(reviewerID uint) ([]uint, error) {
+	var ids []uint
+	err := r.db.Model(&models.ReviewDecision{}).
+		Where("reviewer_id = ? AND closed = ?", reviewerID, false).
+		Pluck("post_id", &ids).Error
+	return ids, err
+}
+
+// CloseByPost 审核结束后关闭申请本轮的所有意见
+func (r *ReviewDecisionRepository) CloseByPost(postID uint) error {
+	return r.db.Model(&models.ReviewDecision{}).
+		Where("post_id = ? AND closed = ?", postID, false).
+		Update("closed", true).Error
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"time"
+
+	"linuxdo-review/models"
+
+	"gorm.io/gorm"
+)
+
+// ReviewLockRepository 审核锁定记录仓库
+type ReviewLockRepository struct {
+	db *gorm.DB
+}
+
+// NewReviewLockRepository 创建审核锁定记录仓库
+func NewReviewLockRepository(db *gorm.DB) *ReviewLockRepository {
+	return &ReviewLockRepository{db: db}
+}
+
+// WithTx 返回使用指定事务的审核锁定记录仓库
+func (r *ReviewLockRepository) WithTx(tx *gorm.DB) *ReviewLockRepository {
+	return &ReviewLockRepository{db: tx}
+}
+
+// Open 记录审核员领取申请(已有未释放的记录时不重复创建)
+func (r *ReviewLockRepository) Open(postID, userID uint) error {
+	var count int64
+	err := r.db.Model(&models.ReviewLock{}).
+		Where("post_id = ? AND user_id = ? AND released_at IS NULL", postID, userID).
+		Count(&count).Error
+	if err != nil || count > 0 {
+		return err
+	}
+	return r.db.Create(&models.ReviewLock{PostID: postID, UserID: userID, LockedAt: time.Now()}).Error
+}
+
+// Close 释放审核员对申请的锁定记录
+func (r *ReviewLockRepository) Close(postID, userID uint, reason string, releasedBy *uint) error {
+	return r.db.Model(&models.ReviewLock{}).
+		Where("post_id = ? AND user_id = ? AND released_at IS NULL", postID, userID).
+		Updates(map[string]interface{}{
+			"released_at":    time.Now(),
+			"release_reason": reason,
+			"released_by":    releasedBy,
+		}).Error
+}
+
+// CloseByPost 释放申请所有未释放的锁定记录(申请离开二级审核时使用)
+func (r *ReviewLockRepository) CloseByPost(postID uint, reason string, releasedBy *uint) error {
+	return r.db.Model(&models.ReviewLock{}).
+		Where("post_id = ? AND released_at IS NULL", postID).
+		Updates(map[string]interface{}{
+			"released_at":    time.Now(),
+			"release_reason": reason,
+			"released_by":    releasedBy,
+		}).Error
+}
+
+// ListActive 获取所有未释放的锁定记录(包含申请和审核员)
+func (r *ReviewLockRepository) ListActive() ([]*models.ReviewLock, error) {
+	var locks []*models.ReviewLock
+	err := r.db.Preload("Post").Preload("User").
+		Where("released_at IS NULL").
+		Order("locked_at ASC").
+		Find(&locks).Error
+	return locks, err
+}
+
+// ListReleased 获取已释放的锁定记录(分页，最近释放的在前)
+func (r *ReviewLockRepository) ListReleased(offset, limit int) ([]*models.ReviewLock, int64, error) {
+	var locks []*models.ReviewLock
+	var total int64
+
+	query := r.db.Model(&models.ReviewLock{}).Where("released_at IS NOT NULL")
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Preload("Post").Preload("User").
+		Order("released_at DESC").
+		Offset(offset).Limit(limit).
+		Find(&locks).Error
+	return locks, total, err
+}
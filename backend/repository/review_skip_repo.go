@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"linuxdo-review/models"
+
+	"gorm.io/gorm"
+)
+
+// ReviewSkipRepository 审核员跳过记录仓库
+type ReviewSkipRepository struct {
+	db *gorm.DB
+}
+
+// NewReviewSkipRepository 创建审核员跳过记录仓库
+func NewReviewSkipRepository(db *gorm.DB) *ReviewSkipRepository {
+	return &ReviewSkipRepository{db: db}
+}
+
+// Save 记录跳过(已跳过过的申请累加次数并更新过期时间)
+func (r *ReviewSkipRepository) Save(userID, postID uint, expiresAt *time.Time) error {
+	var skip models.ReviewSkip
+	err := r.db.Where("user_id = ? AND post_id = ?", userID, postID).First(&skip).Error
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		return r.db.Create(&models.ReviewSkip{UserID: userID, PostID: postID, Times: 1, ExpiresAt: expiresAt}).Error
+	}
+	return r.db.Model(&skip).Updates(map[string]interface{}{
+		"times":      gorm.Expr("times + 1"),
+		"expires_at": expiresAt,
+	}).Error
+}
+
+// ListActivePostIDs 获取审核员当前仍然有效的跳过申请ID
+func (r *ReviewSkipRepository) ListActivePostIDs(userID uint, now time.Time) ([]uint, error) {
+	var ids []uint
+	err := r.db.Model(&models.ReviewSkip{}).
+		Where("user_id = ? AND (expires_at IS NULL OR expires_at > ?)", userID, now).
+		Pluck("post_id", &ids).Error
+	return ids, err
+}
+
+// ListActiveByUser 获取审核员当前仍然有效的跳过记录(包含申请)
+func (r *ReviewSkipRepository) ListActiveByUser(userID uint, now time.Time) ([]*models.ReviewSkip, error) {
+	var skips []*models.ReviewSkip
+	err := r.db.Preload("Post").
+		Where("user_id = ? AND (expires_at IS NULL OR expires_at > ?)", userID, now).
+		Order("updated_at DESC").
+		Find(&skips).Error
+	return skips, err
+}
+
+// Delete 取消跳过
+func (r *ReviewSkipRepository) Delete(userID, postID uint) error {
+	result := r.db.Where("user_id = ? AND post_id = ?", userID, postID).Delete(&models.ReviewSkip{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// ListMostSkipped 统计仍在二级审核中、被至少 minReviewers 名审核员跳过的申请(按跳过人数降序)
+func (r *ReviewSkipRepository) ListMostSkipped(minReviewers int, limit int) ([]*models.SkippedPostStat, error) {
+	var stats []*models.SkippedPostStat
+	err := r.db.Model(&models.ReviewSkip{}).
+		Select("review_skips.post_id, COUNT(*) AS reviewers, SUM(review_skips.times) AS skips").
+		Joins("JOIN posts ON posts.id = review_skips.post_id").
+		Where("posts.status = ?", models.StatusSecondReview).
+		Group("review_skips.post_id").
+		Having("COUNT(*) >= ?", minReviewers).
+		Order("reviewers DESC, skips DESC").
+		Limit(limit).
+		Scan(&stats).Error
+	return stats, err
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"linuxdo-review/models"
+
+	"gorm.io/gorm"
+)
+
+// ReviewerNoteRepository 审核员备注仓库
+type ReviewerNoteRepository struct {
+	db *gorm.DB
+}
+
+// NewReviewerNoteRepository 创建审核员备注仓库
+func NewReviewerNoteRepository(db *gorm.DB) *ReviewerNoteRepository {
+	return &ReviewerNoteRepository{db: db}
+}
+
+// Create 创建审核员备注
+func (r *ReviewerNoteRepository) Create(note *models.ReviewerNote) error {
+	return r.db.Create(note).Error
+}
+
+// ListByPost 获取申请的审核员备注(按时间升序)
+func (r *ReviewerNoteRepository) ListByPost(postID uint) ([]*models.ReviewerNote, error) {
+	var notes []*models.ReviewerNote
+	err := r.db.Preload("User").Where("post_id = ?", postID).Order("created_at ASC").Find(&notes).Error
+	return notes, err
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"linuxdo-review/models"
+
+	"gorm.io/gorm"
+)
+
+// RevisionRepository 帖子修订记录仓库
+type RevisionRepository struct {
+	db *gorm.DB
+}
+
+// NewRevisionRepository 创建帖子修订记录仓库
+func NewRevisionRepository(db *gorm.DB) *RevisionRepository {
+	return &RevisionRepository{db: db}
+}
+
+// WithTx 返回使用指定事务的修订记录仓库
+func (r *RevisionRepository) WithTx(tx *gorm.DB) *RevisionRepository {
+	return &RevisionRepository{db: tx}
+}
+
+// Create 创建修订记录
+func (r *RevisionRepository) Create(revision *models.PostRevision) error {
+	return r.db.Create(revision).Error
+}
+
+// ListByPostID 获取帖子的所有修订记录(按版本号升序)
+func (r *RevisionRepository) ListByPostID(postID uint) ([]*models.PostRevision, error) {
+	var revisions []*models.PostRevision
+	err := r.db.Preload("Editor").Where("post_id = ?", postID).Order("version ASC").Find(&revisions).Error
+	return revisions, err
+}
+
+// FindByPostAndVersion 根据帖子ID和版本号查找修订记录
+func (r *RevisionRepository) FindByPostAndVersion(postID uint, version int) (*models.PostRevision, error) {
+	var revision models.PostRevision
+	err := r.db.Preload("Editor").Where("post_id = ? AND version = ?", postID, version).First(&revision).Error
+	if err != nil {
+		return nil, err
+	}
+	return &revision, nil
+}
+
+// LatestVersion 获取帖子的最新版本号(没有修订记录时返回0)
+func (r *RevisionRepository) LatestVersion(postID uint) (int, error) {
+	var version int
+	err := r.db.Model(&models.PostRevision{}).Where("post_id = ?", postID).
+		Select("COALESCE(MAX(version), 0)").Scan(&version).Error
+	return version, err
+}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"linuxdo-review/models"
+
+	"gorm.io/gorm"
+)
+
+// SimilarityRepository 相似度仓库
+type SimilarityRepository struct {
+	db *gorm.DB
+}
+
+// NewSimilarityRepository 创建相似度仓库
+func NewSimilarityRepository(db *gorm.DB) *SimilarityRepository {
+	return &SimilarityRepository{db: db}
+}
+
+// SaveSignature 保存帖子签名(已存在则覆盖)
+func (r *SimilarityRepository) SaveSignature(sig *models.PostSignature) error {
+	return r.db.Save(sig).Error
+}
+
+// ListPostsWithoutSignature 获取尚未计算签名的帖子(按ID升序)
+func (r *SimilarityRepository) ListPostsWithoutSignature(limit int) ([]*models.Post, error) {
+	var posts []*models.Post
+	err := r.db.Select("id", "user_id", "title", "content").
+		Where("id NOT IN (?)", r.db.Model(&models.PostSignature{}).Select("post_id")).
+		Order("id ASC").Limit(limit).Find(&posts).Error
+	return posts, err
+}
+
+// ListSignaturesExcludingUser 获取除指定用户外所有帖子的签名
+func (r *SimilarityRepository) ListSignaturesExcludingUser(userID uint) ([]*models.PostSignature, error) {
+	var sigs []*models.PostSignature
+	err := r.db.Where("user_id <> ?", userID).Find(&sigs).Error
+	return sigs, err
+}
+
+// ReplaceMatches 替换帖子的相似匹配记录
+func (r *SimilarityRepository) ReplaceMatches(postID uint, matches []*models.PostSimilarity) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("post_id = ?", postID).Delete(&models.PostSimilarity{}).Error; err != nil {
+			return err
+		}
+		if len(matches) == 0 {
+			return nil
+		}
+		return tx.Create(&matches).Error
+	})
+}
+
+// ListMatches 获取帖子的相似匹配记录(按相似度降序)
+func (r *SimilarityRepository) ListMatches(postID uint) ([]*models.PostSimilarity, error) {
+	var matches []*models.PostSimilarity
+	err := r.db.Preload("MatchPost").Preload("MatchPost.User").
+		Where("post_id = ?", postID).Order("score DESC").Find(&matches).Error
+	return matches, err
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"time"
+
+	"linuxdo-review/models"
+
+	"gorm.io/gorm"
+)
+
+// TokenRepository 个人访问令牌仓库
+type TokenRepository struct {
+	db *gorm.DB
+}
+
+// NewTokenRepository 创建个人访问令牌仓库
+func NewTokenRepository(db *gorm.DB) *TokenRepository {
+	return &TokenRepository{db: db}
+}
+
+// Create 创建令牌
+func (r *TokenRepository) Create(token *models.APIToken) error {
+	return r.db.Create(token).Error
+}
+
+// FindByHash 根据令牌哈希查找令牌(包含用户)
+func (r *TokenRepository) FindByHash(hash string) (*models.APIToken, error) {
+	var token models.APIToken
+	err := r.db.Preload("User").Where("token_hash = ?", hash).First(&token).Error
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// ListByUserID 获取用户的所有令牌
+func (r *TokenRepository) ListByUserID(userID uint) ([]*models.APIToken, error) {
+	var tokens []*models.APIToken
+	err := r.db.Where("user_id = ?", userID).Order("created_at DESC").Find(&tokens).Error
+	return tokens, err
+}
+
+// CountByUserID 统计用户的令牌数量
+func (r *TokenRepository) CountByUserID(userID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.APIToken{}).Where("user_id = ?", userID).Count(&count).Error
+	return count, err
+}
+
+// DeleteByIDAndUser 删除用户的指定令牌
+func (r *TokenRepository) DeleteByIDAndUser(id, userID uint) error {
+	result := r.db.Where("id = ? AND user_id = ?", id, userID).Delete(&models.APIToken{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// UpdateLastUsed 更新最后使用时间
+func (r *TokenRepository) UpdateLastUsed(id uint, usedAt time.Time) error {
+	return r.db.Model(&models.APIToken{}).Where("id = ?", id).Update("last_used_at", usedAt).Error
+}
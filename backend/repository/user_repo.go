@@ -31,6 +31,16 @@ func (r *UserRepository) FindByID(id uint) (*models.User, error) {
 	return &user, nil
 }
 
+// FindByIDs 根据ID批量查找用户
+func (r *UserRepository) FindByIDs(ids []uint) ([]*models.User, error) {
+	var users []*models.User
+	if len(ids) == 0 {
+		return users, nil
+	}
+	err := r.db.Where("id IN ?", ids).Order("id ASC").Find(&users).Error
+	return users, err
+}
+
 // FindByEmail 根据邮箱查找用户
 func (r *UserRepository) FindByEmail(email string) (*models.User, error) {
 	var user models.User
@@ -77,6 +87,13 @@ func (r *UserRepository) List(offset, limit int) ([]*models.User, int64, error)
 	return users, total, nil
 }
 
+// ListByMinRole 获取角色不低于指定角色的所有用户
+func (r *UserRepository) ListByMinRole(role models.UserRole) ([]*models.User, error) {
+	var users []*models.User
+	err := r.db.Where("role >= ?", role).Order("id ASC").Find(&users).Error
+	return users, err
+}
+
 // CountByRole 统计指定角色的用户数量
 func (r *UserRepository) CountByRole(role models.UserRole) (int64, error) {
 	var count int64
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"time"
+
+	"linuxdo-review/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// VoteClusterRepository 投票圈仓库
+type VoteClusterRepository struct {
+	db *gorm.DB
+}
+
+// NewVoteClusterRepository 创建投票圈仓库
+func NewVoteClusterRepository(db *gorm.DB) *VoteClusterRepository {
+	return &VoteClusterRepository{db: db}
+}
+
+// WithTx 返回使用指定事务的投票圈仓库
+func (r *VoteClusterRepository) WithTx(tx *gorm.DB) *VoteClusterRepository {
+	return &VoteClusterRepository{db: tx}
+}
+
+// ReplaceOpen 用新的分析结果替换所有待处理的投票圈(已处理的保留)
+func (r *VoteClusterRepository) ReplaceOpen(clusters []*models.VoteCluster) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("status = ?", models.VoteClusterOpen).Delete(&models.VoteCluster{}).Error; err != nil {
+			return err
+		}
+		if len(clusters) == 0 {
+			return nil
+		}
+		return tx.Create(&clusters).Error
+	})
+}
+
+// ListHandled 获取所有已处理的投票圈
+func (r *VoteClusterRepository) ListHandled() ([]*models.VoteCluster, error) {
+	var clusters []*models.VoteCluster
+	err := r.db.Where("status <> ?", models.VoteClusterOpen).Find(&clusters).Error
+	return clusters, err
+}
+
+// FindByID 根据ID查找投票圈
+func (r *VoteClusterRepository) FindByID(id uint) (*models.VoteCluster, error) {
+	var cluster models.VoteCluster
+	err := r.db.Preload("Handler").First(&cluster, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &cluster, nil
+}
+
+// List 获取投票圈列表(分页)，status 为 nil 时返回所有状态
+func (r *VoteClusterRepository) List(status *int, offset, limit int) ([]*models.VoteCluster, int64, error) {
+	var clusters []*models.VoteCluster
+	var total int64
+
+	query := r.db.Model(&models.VoteCluster{})
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.Preload("Handler").Order("avg_agreement DESC, id ASC").
+		Offset(offset).Limit(limit).Find(&clusters).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return clusters, total, nil
+}
+
+// Decide 处理投票圈(只有待处理的可以被处理)
+func (r *VoteClusterRepository) Decide(id uint, status models.VoteClusterStatus, handlerID uint, discounted int) error {
+	result := r.db.Model(&models.VoteCluster{}).
+		Where("id = ? AND status = ?", id, models.VoteClusterOpen).
+		Updates(map[string]interface{}{
+			"status":     status,
+			"handler_id": handlerID,
+			"discounted": discounted,
+			"handled_at": time.Now(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// CreateDiscounts 记录投票圈成员在相关申请上的投票作废(已有记录的忽略)
+func (r *VoteClusterRepository) CreateDiscounts(clusterID uint, userIDs, postIDs []uint) error {
+	discounts := make([]*models.VoteRingDiscount, 0, len(userIDs)*len(postIDs))
+	for _, userID := range userIDs {
+		for _, postID := range postIDs {
+			discounts = append(discounts, &models.VoteRingDiscount{ClusterID: clusterID, UserID: userID, PostID: postID})
+		}
+	}
+	if len(discounts) == 0 {
+		return nil
+	}
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&discounts).Error
+}
+
+// FindDiscount 查找用户在帖子上的投票作废记录
+func (r *VoteClusterRepository) FindDiscount(userID, postID uint) (*models.VoteRingDiscount, error) {
+	var discount models.VoteRingDiscount
+	err := r.db.Where("user_id = ? AND post_id = ?", userID, postID).First(&discount).Error
+	if err != nil {
+		return nil, err
+	}
+	return &discount, nil
+}
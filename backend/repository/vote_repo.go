@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"time"
+
 	"linuxdo-review/models"
 
 	"gorm.io/gorm"
@@ -16,6 +18,11 @@ func NewVoteRepository(db *gorm.DB) *VoteRepository {
 	return &VoteRepository{db: db}
 }
 
+// WithTx 返回使用指定事务的投票仓库
+func (r *VoteRepository) WithTx(tx *gorm.DB) *VoteRepository {
+	return &VoteRepository{db: tx}
+}
+
 // Create 创建投票
 func (r *VoteRepository) Create(vote *models.Vote) error {
 	return r.db.Create(vote).Error
@@ -41,17 +48,17 @@ func (r *VoteRepository) Delete(vote *models.Vote) error {
 	return r.db.Delete(vote).Error
 }
 
-// CountByPost 统计帖子的投票数
+// CountByPost 统计帖子的有效投票数(不含不满足资格要求的投票)
 func (r *VoteRepository) CountByPost(postID uint) (upVotes int64, downVotes int64, err error) {
 	err = r.db.Model(&models.Vote{}).
-		Where("post_id = ? AND vote_type = ?", postID, models.VoteUp).
+		Where("post_id = ? AND vote_type = ? AND ineligible = ?", postID, models.VoteUp, false).
 		Count(&upVotes).Error
 	if err != nil {
 		return 0, 0, err
 	}
 
 	err = r.db.Model(&models.Vote{}).
-		Where("post_id = ? AND vote_type = ?", postID, models.VoteDown).
+		Where("post_id = ? AND vote_type = ? AND ineligible = ?", postID, models.VoteDown, false).
 		Count(&downVotes).Error
 	if err != nil {
 		return 0, 0, err
@@ -81,6 +88,119 @@ func (r *VoteRepository) GetUserVotesForPosts(userID uint, postIDs []uint) (map[
 	return result, nil
 }
 
+// MarkStaleByPost 将帖子的所有投票标记为修改前的投票
+func (r *VoteRepository) MarkStaleByPost(postID uint) error {
+	return r.db.Model(&models.Vote{}).Where("post_id = ?", postID).Update("stale", true).Error
+}
+
+// DeleteByPost 删除帖子的所有投票
+func (r *VoteRepository) DeleteByPost(postID uint) error {
+	return r.db.Where("post_id = ?", postID).Delete(&models.Vote{}).Error
+}
+
+// CountDownvoteReasons 按分类统计帖子的反对原因(按数量降序)
+func (r *VoteRepository) CountDownvoteReasons(postID uint) ([]models.DownvoteReasonCount, error) {
+	var counts []models.DownvoteReasonCount
+	err := r.db.Model(&models.Vote{}).
+		Select("reason_category AS category, COUNT(*) AS count").
+		Where("post_id = ? AND vote_type = ? AND ineligible = ? AND reason_category <> ''", postID, models.VoteDown, false).
+		Group("reason_category").
+		Order("count DESC, category ASC").
+		Scan(&counts).Error
+	return counts, err
+}
+
+// ListDownvoteReasonTexts 获取帖子反对票中填写的原因说明
+func (r *VoteRepository) ListDownvoteReasonTexts(postID uint) ([]string, error) {
+	var reasons []string
+	err := r.db.Model(&models.Vote{}).
+		Where("post_id = ? AND vote_type = ? AND reason <> ''", postID, models.VoteDown).
+		Order("created_at ASC").
+		Pluck("reason", &reasons).Error
+	return reasons, err
+}
+
+// CountByUserExcludingPost 统计用户在其他帖子上的投票数量
+// 仅因投票数不足而不计票的投票也计入，因信任等级、信誉或投票圈等原因不计票的投票不计入
+func (r *VoteRepository) CountByUserExcludingPost(userID, postID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Vote{}).
+		Where("user_id = ? AND post_id <> ? AND (ineligible = ? OR ineligible_code = ?)", userID, postID, false, models.IneligiblePriorVotes).
+		Count(&count).Error
+	return count, err
+}
+
+// ListCountedSince 获取指定时间之后的有效投票(用于投票圈分析)
+func (r *VoteRepository) ListCountedSince(since time.Time) ([]*models.Vote, error) {
+	var votes []*models.Vote
+	err := r.db.Select("user_id", "post_id", "vote_type", "created_at").
+		Where("created_at >= ? AND ineligible = ?", since, false).
+		Find(&votes).Error
+	return votes, err
+}
+
+// DiscountOnOpenPosts 将用户在仍处于待审核或投票中的帖子上的投票标记为不计票
+// 返回受影响的帖子ID和作废的投票数
+func (r *VoteRepository) DiscountOnOpenPosts(userIDs, postIDs []uint, note string) ([]uint, int64, error) {
+	var affected []uint
+	if len(userIDs) == 0 || len(postIDs) == 0 {
+		return affected, 0, nil
+	}
+
+	openStatuses := []models.PostStatus{models.StatusPending, models.StatusFirstReview}
+	err := r.db.Model(&models.Vote{}).
+		Joins("JOIN posts ON posts.id = votes.post_id").
+		Where("votes.user_id IN ? AND votes.post_id IN ? AND votes.ineligible = ? AND posts.status IN ?", userIDs, postIDs, false, openStatuses).
+		Distinct().Pluck("votes.post_id", &affected).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(affected) == 0 {
+		return affected, 0, nil
+	}
+
+	result := r.db.Model(&models.Vote{}).
+		Where("user_id IN ? AND post_id IN ? AND ineligible = ?", userIDs, affected, false).
+		Updates(map[string]interface{}{
+			"ineligible":      true,
+			"ineligible_code": models.IneligibleVoteRing,
+			"ineligible_note": note,
+		})
+	return affected, result.RowsAffected, result.Error
+}
+
+// ListUnsettledByPost 获取帖子中尚未计入信誉的有效投票
+func (r *VoteRepository) ListUnsettledByPost(postID uint) ([]*models.Vote, error) {
+	var votes []*models.Vote
+	err := r.db.Where("post_id = ? AND ineligible = ? AND settled = ?", postID, false, false).Find(&votes).Error
+	return votes, err
+}
+
+// MarkSettled 将投票标记为已计入信誉
+func (r *VoteRepository) MarkSettled(voteIDs []uint) error {
+	if len(voteIDs) == 0 {
+		return nil
+	}
+	return r.db.Model(&models.Vote{}).Where("id IN ?", voteIDs).Update("settled", true).Error
+}
+
+// ListCountedByPost 获取帖子的有效投票
+func (r *VoteRepository) ListCountedByPost(postID uint) ([]*models.Vote, error) {
+	var votes []*models.Vote
+	err := r.db.Where("post_id = ? AND ineligible = ?", postID, false).Find(&votes).Error
+	return votes, err
+}
+
+// ListCountedByPosts 批量获取多个帖子的有效投票
+func (r *VoteRepository) ListCountedByPosts(postIDs []uint) ([]*models.Vote, error) {
+	var votes []*models.Vote
+	if len(postIDs) == 0 {
+		return votes, nil
+	}
+	err := r.db.Where("post_id IN ? AND ineligible = ?", postIDs, false).Find(&votes).Error
+	return votes, err
+}
+
 // CountAll 统计所有投票数量
 func (r *VoteRepository) CountAll() (int64, error) {
 	var count int64
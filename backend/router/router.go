@@ -9,6 +9,7 @@ import (
 	"linuxdo-review/config"
 	"linuxdo-review/handler"
 	"linuxdo-review/middleware"
+	"linuxdo-review/models"
 
 	"github.com/gin-gonic/gin"
 )
@@ -21,6 +22,16 @@ func SetupRouter(
 	postHandler *handler.PostHandler,
 	reviewHandler *handler.ReviewHandler,
 	adminHandler *handler.AdminHandler,
+	tokenHandler *handler.TokenHandler,
+	appealHandler *handler.AppealHandler,
+	commentHandler *handler.CommentHandler,
+	formHandler *handler.FormHandler,
+	moderationHandler *handler.ModerationHandler,
+	contentRuleHandler *handler.ContentRuleHandler,
+	reportHandler *handler.ReportHandler,
+	voteRingHandler *handler.VoteRingHandler,
+	reputationHandler *handler.ReputationHandler,
+	conflictHandler *handler.ConflictHandler,
 ) *gin.Engine {
 	// 设置运行模式
 	gin.SetMode(cfg.Server.Mode)
@@ -47,22 +58,49 @@ func SetupRouter(
 			auth.GET("/oauth/linuxdo/callback", authHandler.OAuthLinuxDoCallback)
 
 			// 需要登录
-			auth.GET("/me", middleware.JWTAuth(cfg), authHandler.Me)
+			auth.GET("/me", middleware.JWTAuth(cfg, models.ScopeReadPosts, models.ScopeReview, models.ScopeAdminRead), authHandler.Me)
 		}
 
 		// 帖子相关
 		posts := api.Group("/posts")
 		{
 			// 公开接口(可选登录,用于显示投票状态)
-			posts.GET("", middleware.OptionalJWTAuth(cfg), postHandler.List)
-			posts.GET("/:id", middleware.OptionalJWTAuth(cfg), postHandler.Get)
+			posts.GET("", middleware.OptionalJWTAuth(cfg, models.ScopeReadPosts), postHandler.List)
+			posts.GET("/vote-options", postHandler.VoteOptions)
+			posts.GET("/form", formHandler.Current)
+			posts.GET("/:id", middleware.OptionalJWTAuth(cfg, models.ScopeReadPosts), postHandler.Get)
+			posts.GET("/:id/revisions", middleware.OptionalJWTAuth(cfg, models.ScopeReadPosts), postHandler.ListRevisions)
+			posts.GET("/:id/revisions/:version", middleware.OptionalJWTAuth(cfg, models.ScopeReadPosts), postHandler.GetRevision)
+			posts.GET("/:id/history", middleware.OptionalJWTAuth(cfg, models.ScopeReadPosts), postHandler.ListEvents)
+			posts.GET("/:id/comments", middleware.OptionalJWTAuth(cfg, models.ScopeReadPosts), commentHandler.List)
 
 			// 需要登录
 			posts.POST("", middleware.JWTAuth(cfg), postHandler.Create)
+			posts.PUT("/:id", middleware.JWTAuth(cfg), postHandler.Update)
+			posts.POST("/:id/withdraw", middleware.JWTAuth(cfg), postHandler.Withdraw)
 			posts.POST("/:id/vote", middleware.JWTAuth(cfg), postHandler.Vote)
+			posts.GET("/:id/downvote-reasons", middleware.JWTAuth(cfg, models.ScopeReadPosts), postHandler.DownvoteReasons)
+			posts.POST("/:id/appeal", middleware.JWTAuth(cfg), appealHandler.Create)
+			posts.GET("/:id/appeal", middleware.JWTAuth(cfg), appealHandler.GetByPost)
+			posts.POST("/:id/comments", middleware.JWTAuth(cfg), commentHandler.Create)
 
 			// 认证用户专属(二级审核列表)
-			posts.GET("/review", middleware.JWTAuth(cfg), middleware.RequireCertified(), postHandler.ListForReview)
+			posts.GET("/review", middleware.JWTAuth(cfg, models.ScopeReview), middleware.RequireCertified(), postHandler.ListForReview)
+			posts.GET("/:id/similar", middleware.JWTAuth(cfg, models.ScopeReview), middleware.RequireCertified(), postHandler.ListSimilar)
+		}
+
+		// 投票者信誉(公开)
+		reputation := api.Group("/reputation")
+		{
+			reputation.GET("/leaderboard", reputationHandler.Leaderboard)
+			reputation.GET("/users/:id", reputationHandler.GetUser)
+		}
+
+		// 评论相关(需要登录)
+		comments := api.Group("/comments", middleware.JWTAuth(cfg))
+		{
+			comments.PUT("/:id", commentHandler.Update)
+			comments.DELETE("/:id", commentHandler.Delete) // 作者在时间窗口内或管理员可删除
 		}
 
 		// 用户相关(需要登录)
@@ -77,24 +115,106 @@ func SetupRouter(
 			user.POST("/email/code", authHandler.SendEmailCode) // 发送邮箱验证码
 			user.POST("/email/change", authHandler.ChangeEmail) // 修改邮箱
 			user.PUT("/avatar", authHandler.UpdateAvatar)       // 更新头像
+
+			// 个人访问令牌(只能通过登录JWT管理)
+			user.GET("/tokens", tokenHandler.List)
+			user.POST("/tokens", tokenHandler.Create)
+			user.DELETE("/tokens/:id", tokenHandler.Revoke)
 		}
 
 		// 审核相关(认证用户专属)
-		review := api.Group("/review", middleware.JWTAuth(cfg), middleware.RequireCertified())
+		review := api.Group("/review", middleware.JWTAuth(cfg, models.ScopeReview), middleware.RequireCertified())
+		{
+			review.GET("/next", reviewHandler.GetNext)             // 获取下一个待审核的帖子
+			review.POST("/:id/skip", reviewHandler.Skip)           // 跳过当前帖子
+			review.POST("/:id/heartbeat", reviewHandler.Heartbeat) // 续期锁定
+			review.GET("/skips", reviewHandler.ListSkips)          // 我跳过的申请
+			review.DELETE("/skips/:id", reviewHandler.Unskip)      // 取消跳过
+			review.POST("/:id/approve", reviewHandler.Approve)     // 通过审核
+			review.POST("/:id/reject", reviewHandler.Reject)       // 拒绝申请
+			review.GET("/:id/decisions", reviewHandler.Decisions)  // 审核进度及审核员意见
+			review.GET("/:id/notes", reviewHandler.ListNotes)      // 审核员内部备注
+			review.POST("/:id/notes", reviewHandler.AddNote)       // 添加内部备注
+		}
+
+		// 预审队列(认证用户专属)
+		moderation := api.Group("/moderation", middleware.JWTAuth(cfg, models.ScopeReview), middleware.RequireCertified())
+		{
+			moderation.GET("", moderationHandler.List)                 // 待审核的申请
+			moderation.POST("/:id/release", moderationHandler.Release) // 放行到社区投票
+			moderation.POST("/:id/reject", moderationHandler.Reject)   // 预审拒绝
+		}
+
+		// 申诉处理(认证用户专属)
+		appeals := api.Group("/appeals", middleware.JWTAuth(cfg), middleware.RequireCertified())
+		{
+			appeals.GET("", appealHandler.List)               // 申诉队列
+			appeals.GET("/:id", appealHandler.Get)            // 申诉详情(含内部备注)
+			appeals.POST("/:id/accept", appealHandler.Accept) // 接受申诉并重新开启申请
+			appeals.POST("/:id/deny", appealHandler.Deny)     // 驳回申诉
+			appeals.POST("/:id/notes", appealHandler.AddNote) // 添加内部备注
+		}
+
+		// 举报(登录用户)
+		reports := api.Group("/reports", middleware.JWTAuth(cfg))
 		{
-			review.GET("/next", reviewHandler.GetNext)         // 获取下一个待审核的帖子
-			review.POST("/:id/skip", reviewHandler.Skip)       // 跳过当前帖子
-			review.POST("/:id/approve", reviewHandler.Approve) // 通过审核
-			review.POST("/:id/reject", reviewHandler.Reject)   // 拒绝申请
+			reports.GET("/categories", reportHandler.Categories) // 举报分类
+			reports.POST("", reportHandler.Create)               // 举报申请、评论或用户
 		}
 
-		// 管理后台(管理员专属)
-		admin := api.Group("/admin", middleware.JWTAuth(cfg), middleware.RequireAdmin())
+		// 管理后台(管理员专属，个人访问令牌只能只读访问)
+		admin := api.Group("/admin", middleware.JWTAuth(cfg, models.ScopeAdminRead), middleware.RequireAdmin())
 		{
 			// 用户管理
 			admin.GET("/users", adminHandler.ListUsers)
 			admin.GET("/users/:id", adminHandler.GetUser)
 			admin.PUT("/users/:id", adminHandler.UpdateUserRole)
+			admin.GET("/users/:id/apply-status", adminHandler.GetUserApplyStatus)
+			admin.PUT("/users/:id/exemption", adminHandler.GrantExemption)
+			admin.DELETE("/users/:id/exemption", adminHandler.RevokeExemption)
+
+			// 评论管理
+			admin.PUT("/comments/:id/hidden", commentHandler.SetHidden)
+			admin.PUT("/posts/:id/comments-lock", commentHandler.SetLocked)
+
+			// 申请内容渲染(修改渲染配置后重新生成)
+			admin.POST("/posts/render", postHandler.RenderContent)
+
+			// 申请表管理(每次发布生成新版本)
+			admin.GET("/forms", formHandler.ListVersions)
+			admin.GET("/forms/:version", formHandler.GetVersion)
+			admin.POST("/forms", formHandler.Publish)
+
+			// 内容规则管理
+			admin.GET("/content-rules", contentRuleHandler.List)
+			admin.POST("/content-rules", contentRuleHandler.Create)
+			admin.POST("/content-rules/test", contentRuleHandler.Test)
+			admin.PUT("/content-rules/:id", contentRuleHandler.Update)
+			admin.DELETE("/content-rules/:id", contentRuleHandler.Delete)
+
+			// 举报处理(处理一条举报时同时处理该对象的其他待处理举报)
+			admin.GET("/reports", reportHandler.List)
+			admin.POST("/reports/:id/resolve", reportHandler.Resolve)
+			admin.POST("/reports/:id/dismiss", reportHandler.Dismiss)
+
+			// 投票圈检测
+			admin.GET("/vote-clusters", voteRingHandler.List)
+			admin.POST("/vote-clusters/analyze", voteRingHandler.Analyze)
+			admin.GET("/vote-clusters/:id", voteRingHandler.Get)
+			admin.POST("/vote-clusters/:id/discount", voteRingHandler.Discount)
+			admin.POST("/vote-clusters/:id/dismiss", voteRingHandler.Dismiss)
+
+			// 审核锁定管理
+			admin.GET("/review-locks", reviewHandler.ListLocks)
+			admin.GET("/review-locks/history", reviewHandler.LockHistory)
+			admin.POST("/review-locks/:id/release", reviewHandler.ReleaseLock) // id 为申请ID
+			admin.GET("/review-skips", reviewHandler.MostSkipped)              // 被多名审核员跳过的申请
+			admin.PUT("/posts/:id/priority", reviewHandler.SetPriority)        // 审核队列优先级加成
+
+			// 用户关系登记(存在关系的用户不能互相审核)
+			admin.GET("/relationships", conflictHandler.ListRelationships)
+			admin.POST("/relationships", conflictHandler.CreateRelationship)
+			admin.DELETE("/relationships/:id", conflictHandler.DeleteRelationship)
 
 			// 配置管理
 			admin.GET("/configs", adminHandler.GetConfigs)
@@ -113,6 +113,7 @@ func (s *AdminService) GetStats() (*dto.StatsResponse, error) {
 
 	pendingCount, _ := s.postRepo.CountByStatus(models.StatusPending)
 	stats.PendingPosts = pendingCount
+	stats.HeldPosts, _ = s.postRepo.CountHeld()
 
 	firstReviewCount, _ := s.postRepo.CountByStatus(models.StatusFirstReview)
 	stats.FirstReviewPosts = firstReviewCount
@@ -122,6 +123,7 @@ func (s *AdminService) GetStats() (*dto.StatsResponse, error) {
 
 	stats.ApprovedPosts, _ = s.postRepo.CountByStatus(models.StatusApproved)
 	stats.RejectedPosts, _ = s.postRepo.CountByStatus(models.StatusRejected)
+	stats.WithdrawnPosts, _ = s.postRepo.CountByStatus(models.StatusWithdrawn)
 	stats.TodayNewPosts, _ = s.postRepo.CountTodayNew()
 	stats.TodayApproved, _ = s.postRepo.CountTodayApproved()
 
@@ -0,0 +1,238 @@
+package service
+
+import (
+	"errors"
+
+	"linuxdo-review/models"
+	"linuxdo-review/repository"
+
+	"gorm.io/gorm"
+)
+
+// AppealService 申诉服务
+type AppealService struct {
+	appealRepo   *repository.AppealRepository
+	postRepo     *repository.PostRepository
+	voteRepo     *repository.VoteRepository
+	eventRepo    *repository.PostEventRepository
+	emailService *EmailService
+}
+
+// NewAppealService 创建申诉服务
+func NewAppealService(
+	appealRepo *repository.AppealRepository,
+	postRepo *repository.PostRepository,
+	voteRepo *repository.VoteRepository,
+	eventRepo *repository.PostEventRepository,
+	emailService *EmailService,
+) *AppealService {
+	return &AppealService{
+		appealRepo:   appealRepo,
+		postRepo:     postRepo,
+		voteRepo:     voteRepo,
+		eventRepo:    eventRepo,
+		emailService: emailService,
+	}
+}
+
+// Create 对被拒绝的申请提交申诉(每个申请只能申诉一次)
+func (s *AppealService) Create(postID, userID uint, statement string) (*models.Appeal, error) {
+	post, err := s.postRepo.FindByID(postID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("帖子不存在")
+		}
+		return nil, err
+	}
+
+	if post.UserID != userID {
+		return nil, errors.New("只能对自己的申请提交申诉")
+	}
+
+	if post.Status != models.StatusRejected {
+		return nil, errors.New("只有被拒绝的申请可以申诉")
+	}
+
+	existing, err := s.appealRepo.FindByPostID(postID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, errors.New("该申请已提交过申诉，不能重复申诉")
+	}
+
+	appeal := &models.Appeal{
+		PostID:    postID,
+		UserID:    userID,
+		Statement: statement,
+		Status:    models.AppealPending,
+	}
+	if err := s.appealRepo.Create(appeal); err != nil {
+		return nil, errors.New("提交申诉失败")
+	}
+
+	_ = s.eventRepo.Record(postID, userID, models.PostEventAppeal, "")
+
+	return appeal, nil
+}
+
+// GetByPostForUser 获取申请的申诉(申请者本人或认证用户可查看)
+func (s *AppealService) GetByPostForUser(postID, userID uint, canManage bool) (*models.Appeal, error) {
+	post, err := s.postRepo.FindByID(postID)
+	if err != nil {
+		return nil, errors.New("帖子不存在")
+	}
+
+	if post.UserID != userID && !canManage {
+		return nil, errors.New("无权查看该申诉")
+	}
+
+	appeal, err := s.appealRepo.FindByPostID(postID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("该申请没有申诉")
+		}
+		return nil, err
+	}
+	return appeal, nil
+}
+
+// GetByID 获取申诉详情及内部备注
+func (s *AppealService) GetByID(id uint) (*models.Appeal, []*models.AppealNote, error) {
+	appeal, err := s.appealRepo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, errors.New("申诉不存在")
+		}
+		return nil, nil, err
+	}
+
+	notes, err := s.appealRepo.ListNotes(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return appeal, notes, nil
+}
+
+// List 获取申诉队列
+func (s *AppealService) List(status *int, page, pageSize int) ([]*models.Appeal, int64, error) {
+	offset := (page - 1) * pageSize
+	return s.appealRepo.List(status, offset, pageSize)
+}
+
+// Accept 接受申诉，将申请重新开启到一级审核(清空投票重新计票)或二级审核
+func (s *AppealService) Accept(id, handlerID uint, target models.PostStatus, note string) error {
+	if target != models.StatusFirstReview && target != models.StatusSecondReview {
+		return errors.New("只能重新开启到社区投票或二级审核")
+	}
+
+	appeal, err := s.getPending(id)
+	if err != nil {
+		return err
+	}
+
+	// 申请者已有其他进行中的申请时不能重新开启，避免同时存在两个申请
+	hasPending, err := s.postRepo.HasPendingOrVotingPost(appeal.UserID)
+	if err != nil {
+		return errors.New("检查申请者状态失败")
+	}
+	if hasPending {
+		return errors.New("申请者已有其他进行中的申请，无法重新开启")
+	}
+
+	// 处理申诉和重新开启申请在同一事务中完成，任一步失败时申诉保持待处理以便重试
+	errReopen := errors.New("重新开启申请失败")
+	err = s.postRepo.Transaction(func(tx *gorm.DB) error {
+		postRepo := s.postRepo.WithTx(tx)
+
+		if err := s.appealRepo.WithTx(tx).Decide(id, models.AppealAccepted, handlerID, note, &target); err != nil {
+			return err
+		}
+		if err := postRepo.Reopen(appeal.PostID, target); err != nil {
+			return errReopen
+		}
+
+		// 原投票结果已导致拒绝，重新进入投票时清空投票
+		if target == models.StatusFirstReview {
+			if err := s.voteRepo.WithTx(tx).DeleteByPost(appeal.PostID); err != nil {
+				return err
+			}
+			return postRepo.UpdateVotes(appeal.PostID, 0, 0)
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("申诉已被处理")
+		}
+		if errors.Is(err, errReopen) {
+			return err
+		}
+		return errors.New("处理申诉失败")
+	}
+
+	_ = s.eventRepo.Record(appeal.PostID, handlerID, models.PostEventAppealAccept, note)
+	s.notifyDecision(appeal, true, note)
+
+	return nil
+}
+
+// Deny 驳回申诉
+func (s *AppealService) Deny(id, handlerID uint, note string) error {
+	appeal, err := s.getPending(id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.appealRepo.Decide(id, models.AppealDenied, handlerID, note, nil); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("申诉已被处理")
+		}
+		return errors.New("处理申诉失败")
+	}
+
+	_ = s.eventRepo.Record(appeal.PostID, handlerID, models.PostEventAppealDeny, note)
+	s.notifyDecision(appeal, false, note)
+
+	return nil
+}
+
+// AddNote 为申诉添加内部备注
+func (s *AppealService) AddNote(id, userID uint, content string) (*models.AppealNote, error) {
+	if _, err := s.appealRepo.FindByID(id); err != nil {
+		return nil, errors.New("申诉不存在")
+	}
+
+	note := &models.AppealNote{
+		AppealID: id,
+		UserID:   userID,
+		Content:  content,
+	}
+	if err := s.appealRepo.CreateNote(note); err != nil {
+		return nil, errors.New("添加备注失败")
+	}
+	return note, nil
+}
+
+// getPending 获取待处理的申诉
+func (s *AppealService) getPending(id uint) (*models.Appeal, error) {
+	appeal, err := s.appealRepo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("申诉不存在")
+		}
+		return nil, err
+	}
+	if !appeal.IsPending() {
+		return nil, errors.New("申诉已被处理")
+	}
+	return appeal, nil
+}
+
+// notifyDecision 发送申诉处理结果邮件
+func (s *AppealService) notifyDecision(appeal *models.Appeal, accepted bool, note string) {
+	if s.emailService == nil || appeal.User == nil || appeal.User.Email == "" || appeal.Post == nil {
+		return
+	}
+	_ = s.emailService.SendAppealDecision(appeal.User.Email, appeal.User.Username, appeal.Post.Title, accepted, note)
+}
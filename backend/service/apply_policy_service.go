@@ -0,0 +1,200 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"linuxdo-review/dto"
+	"linuxdo-review/models"
+	"linuxdo-review/repository"
+
+	"gorm.io/gorm"
+)
+
+// ApplyStatus 用户申请资格状态
+type ApplyStatus struct {
+	RejectionCount int64
+	AttemptCount   int64
+	MaxAttempts    int        // 终身最多申请次数，0表示不限制
+	CooldownUntil  *time.Time // 冷却结束时间，为空表示不在冷却期
+	Exemption      *models.ApplyExemption
+}
+
+// IsExempt 是否处于豁免期
+func (st *ApplyStatus) IsExempt() bool {
+	return st.Exemption.IsActive()
+}
+
+// InCooldown 是否处于冷却期
+func (st *ApplyStatus) InCooldown() bool {
+	return st.CooldownUntil != nil && time.Now().Before(*st.CooldownUntil)
+}
+
+// AttemptsExhausted 申请次数是否已用完
+func (st *ApplyStatus) AttemptsExhausted() bool {
+	return st.MaxAttempts > 0 && st.AttemptCount >= int64(st.MaxAttempts)
+}
+
+// ApplyPolicyService 申请资格策略服务(重新申请冷却期、申请次数上限、豁免)
+type ApplyPolicyService struct {
+	postRepo      *repository.PostRepository
+	configRepo    *repository.ConfigRepository
+	exemptionRepo *repository.ExemptionRepository
+}
+
+// NewApplyPolicyService 创建申请资格策略服务
+func NewApplyPolicyService(
+	postRepo *repository.PostRepository,
+	configRepo *repository.ConfigRepository,
+	exemptionRepo *repository.ExemptionRepository,
+) *ApplyPolicyService {
+	return &ApplyPolicyService{
+		postRepo:      postRepo,
+		configRepo:    configRepo,
+		exemptionRepo: exemptionRepo,
+	}
+}
+
+// GetStatus 获取用户的申请资格状态
+func (s *ApplyPolicyService) GetStatus(userID uint) (*ApplyStatus, error) {
+	status := &ApplyStatus{
+		MaxAttempts: s.configRepo.GetInt(models.ConfigMaxApplyAttempts, models.DefaultMaxApplyAttempts),
+	}
+
+	var err error
+	if status.RejectionCount, err = s.postRepo.CountByUserAndStatus(userID, models.StatusRejected); err != nil {
+		return nil, err
+	}
+	if status.AttemptCount, err = s.postRepo.CountAttemptsByUser(userID); err != nil {
+		return nil, err
+	}
+
+	exemption, err := s.exemptionRepo.FindByUserID(userID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	status.Exemption = exemption
+
+	// 冷却期从最近一次被拒绝开始计算
+	if status.RejectionCount > 0 {
+		cooldown := s.cooldownDuration(status.RejectionCount)
+		if cooldown > 0 {
+			latest, err := s.postRepo.FindLatestRejected(userID)
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, err
+			}
+			if latest != nil {
+				rejectedAt := latest.UpdatedAt
+				if latest.ReviewedAt != nil {
+					rejectedAt = *latest.ReviewedAt
+				}
+				until := rejectedAt.Add(cooldown)
+				if time.Now().Before(until) {
+					status.CooldownUntil = &until
+				}
+			}
+		}
+	}
+
+	return status, nil
+}
+
+// GetStatusResponse 获取用户的申请资格状态响应
+func (s *ApplyPolicyService) GetStatusResponse(userID uint) (*dto.ApplyStatusResponse, error) {
+	status, err := s.GetStatus(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.ApplyStatusResponse{
+		CanApply:          status.IsExempt() || (!status.InCooldown() && !status.AttemptsExhausted()),
+		RejectionCount:    status.RejectionCount,
+		AttemptCount:      status.AttemptCount,
+		MaxAttempts:       status.MaxAttempts,
+		AttemptsRemaining: -1,
+		Exempt:            status.IsExempt(),
+	}
+	if status.MaxAttempts > 0 {
+		resp.AttemptsRemaining = status.MaxAttempts - int(status.AttemptCount)
+		if resp.AttemptsRemaining < 0 {
+			resp.AttemptsRemaining = 0
+		}
+	}
+	if status.InCooldown() {
+		resp.CooldownUntil = status.CooldownUntil.Local().Format("2006-01-02 15:04:05")
+	}
+	if resp.Exempt && status.Exemption.ExpiresAt != nil {
+		resp.ExemptUntil = status.Exemption.ExpiresAt.Format("2006-01-02 15:04:05")
+	}
+
+	return resp, nil
+}
+
+// Check 检查用户当前是否可以发起新申请
+func (s *ApplyPolicyService) Check(userID uint) error {
+	status, err := s.GetStatus(userID)
+	if err != nil {
+		return errors.New("检查申请资格失败")
+	}
+
+	if status.IsExempt() {
+		return nil
+	}
+
+	if status.AttemptsExhausted() {
+		return fmt.Errorf("您的申请次数已达上限(%d次)，如有疑问请联系管理员", status.MaxAttempts)
+	}
+
+	if status.InCooldown() {
+		return fmt.Errorf("您的申请被拒绝后需要等待冷却期，请于 %s 之后再重新申请",
+			status.CooldownUntil.Local().Format("2006-01-02 15:04:05"))
+	}
+
+	return nil
+}
+
+// GrantExemption 授予用户申请限制豁免(expiresInDays为0表示永久有效)
+func (s *ApplyPolicyService) GrantExemption(adminID, userID uint, reason string, expiresInDays int) (*models.ApplyExemption, error) {
+	exemption := &models.ApplyExemption{
+		UserID:    userID,
+		GrantedBy: adminID,
+		Reason:    reason,
+	}
+	if expiresInDays > 0 {
+		expiresAt := time.Now().AddDate(0, 0, expiresInDays)
+		exemption.ExpiresAt = &expiresAt
+	}
+
+	if err := s.exemptionRepo.Save(exemption); err != nil {
+		return nil, errors.New("授予豁免失败")
+	}
+	return exemption, nil
+}
+
+// RevokeExemption 撤销用户的申请限制豁免
+func (s *ApplyPolicyService) RevokeExemption(userID uint) error {
+	return s.exemptionRepo.DeleteByUserID(userID)
+}
+
+// cooldownDuration 根据被拒绝次数计算冷却时间(每多一次拒绝按倍增系数递增，不超过上限)
+func (s *ApplyPolicyService) cooldownDuration(rejections int64) time.Duration {
+	baseHours := s.configRepo.GetFloat(models.ConfigReapplyCooldownHours, models.DefaultReapplyCooldownHours)
+	if baseHours <= 0 || rejections <= 0 {
+		return 0
+	}
+
+	multiplier := s.configRepo.GetFloat(models.ConfigReapplyCooldownMultiplier, models.DefaultReapplyCooldownMultiplier)
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	maxHours := s.configRepo.GetFloat(models.ConfigReapplyCooldownMaxHours, models.DefaultReapplyCooldownMaxHours)
+
+	hours := baseHours * math.Pow(multiplier, float64(rejections-1))
+	if maxHours > 0 && hours > maxHours {
+		hours = maxHours
+	}
+
+	return time.Duration(hours * float64(time.Hour))
+}
@@ -112,6 +112,10 @@ func (s *AuthService) Login(req *dto.LoginRequest) (*dto.LoginResponse, error) {
 		return nil, errors.New("密码错误")
 	}
 
+	if err := s.checkLinuxDoRestriction(user); err != nil {
+		return nil, err
+	}
+
 	// 生成JWT Token
 	token, err := s.jwtManager.GenerateToken(user.ID, user.Email, user.Username, int(user.Role), user.TrustLevel, user.LinuxDoID)
 	if err != nil {
@@ -206,6 +210,8 @@ func (s *AuthService) oauthLoginOrRegister(userInfo *LinuxDoUserInfo) (*dto.Logi
 			LinuxDoUsername: userInfo.Username,
 			AvatarURL:       userInfo.AvatarURL,
 			TrustLevel:      userInfo.TrustLevel,
+			LinuxDoSilenced: userInfo.Silenced,
+			LinuxDoInactive: !userInfo.Active,
 			Role:            role,
 		}
 
@@ -217,6 +223,8 @@ func (s *AuthService) oauthLoginOrRegister(userInfo *LinuxDoUserInfo) (*dto.Logi
 		user.LinuxDoUsername = userInfo.Username
 		user.AvatarURL = userInfo.AvatarURL
 		user.TrustLevel = userInfo.TrustLevel
+		user.LinuxDoSilenced = userInfo.Silenced
+		user.LinuxDoInactive = !userInfo.Active
 
 		// 如果信任等级提升,更新角色
 		if userInfo.TrustLevel >= 2 && user.Role == models.RoleNormal {
@@ -228,6 +236,11 @@ func (s *AuthService) oauthLoginOrRegister(userInfo *LinuxDoUserInfo) (*dto.Logi
 		}
 	}
 
+	// 状态已同步，再根据配置决定是否允许受限账号登录
+	if err := s.checkLinuxDoRestriction(user); err != nil {
+		return nil, err
+	}
+
 	// 生成JWT Token
 	token, err := s.jwtManager.GenerateToken(user.ID, user.Email, user.Username, int(user.Role), user.TrustLevel, user.LinuxDoID)
 	if err != nil {
@@ -240,6 +253,14 @@ func (s *AuthService) oauthLoginOrRegister(userInfo *LinuxDoUserInfo) (*dto.Logi
 	}, nil
 }
 
+// checkLinuxDoRestriction 检查是否因LinuxDo账号被禁言或未激活而禁止登录
+func (s *AuthService) checkLinuxDoRestriction(user *models.User) error {
+	if s.oauthConfig.BlockRestricted && user.IsLinuxDoRestricted() {
+		return errors.New("您的 Linux.do 账号已被禁言或未激活，暂时无法登录")
+	}
+	return nil
+}
+
 // GetOAuthURL 获取OAuth授权URL
 func (s *AuthService) GetOAuthURL() (string, string, error) {
 	if s.oauthConfig.ClientID == "" {
@@ -350,6 +371,8 @@ func (s *AuthService) bindLinuxDoToUser(userID uint, linuxDoInfo *LinuxDoUserInf
 	user.LinuxDoUsername = linuxDoInfo.Username
 	user.AvatarURL = linuxDoInfo.AvatarURL
 	user.TrustLevel = linuxDoInfo.TrustLevel
+	user.LinuxDoSilenced = linuxDoInfo.Silenced
+	user.LinuxDoInactive = !linuxDoInfo.Active
 
 	// 如果信任等级>=2，提升为认证用户
 	if linuxDoInfo.TrustLevel >= 2 && user.Role == models.RoleNormal {
@@ -458,6 +481,8 @@ func (s *AuthService) UnbindLinuxDo(userID uint) (*models.User, error) {
 	user.LinuxDoID = ""
 	user.LinuxDoUsername = ""
 	user.TrustLevel = 0
+	user.LinuxDoSilenced = false
+	user.LinuxDoInactive = false
 
 	// 如果用户是因为LinuxDo而获得的认证用户权限，降级为普通用户
 	// 注意：管理员不会被降级
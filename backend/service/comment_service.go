@@ -0,0 +1,242 @@
+package service
+
+import (
+	"errors"
+
+	"linuxdo-review/models"
+	"linuxdo-review/repository"
+
+	"gorm.io/gorm"
+)
+
+// CommentService 评论服务
+type CommentService struct {
+	commentRepo *repository.CommentRepository
+	postRepo    *repository.PostRepository
+	userRepo    *repository.UserRepository
+	configRepo  *repository.ConfigRepository
+}
+
+// NewCommentService 创建评论服务
+func NewCommentService(
+	commentRepo *repository.CommentRepository,
+	postRepo *repository.PostRepository,
+	userRepo *repository.UserRepository,
+	configRepo *repository.ConfigRepository,
+) *CommentService {
+	return &CommentService{
+		commentRepo: commentRepo,
+		postRepo:    postRepo,
+		userRepo:    userRepo,
+		configRepo:  configRepo,
+	}
+}
+
+// List 分页获取帖子的顶层评论及其全部回复(待审核的帖子只有申请者本人、管理员和认证用户可以查看)
+func (s *CommentService) List(postID, viewerID uint, moderator bool, page, pageSize int) ([]*models.Comment, []*models.Comment, int64, error) {
+	post, err := s.postRepo.FindByID(postID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, 0, errors.New("帖子不存在")
+		}
+		return nil, nil, 0, err
+	}
+	if !post.VisibleTo(viewerID, moderator) {
+		return nil, nil, 0, errors.New("帖子不存在")
+	}
+
+	offset := (page - 1) * pageSize
+	roots, total, err := s.commentRepo.ListRoots(postID, offset, pageSize)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	rootIDs := make([]uint, len(roots))
+	for i, root := range roots {
+		rootIDs[i] = root.ID
+	}
+	replies, err := s.commentRepo.ListByRootIDs(rootIDs)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	return roots, replies, total, nil
+}
+
+// Create 发表评论或回复
+func (s *CommentService) Create(postID, userID uint, parentID *uint, content string) (*models.Comment, error) {
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return nil, errors.New("用户不存在")
+	}
+	if user.IsLinuxDoRestricted() {
+		return nil, errors.New("您的 Linux.do 账号已被禁言或未激活，暂时无法评论")
+	}
+
+	post, err := s.postRepo.FindByID(postID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("帖子不存在")
+		}
+		return nil, err
+	}
+	if post.CommentsLocked {
+		return nil, errors.New("该申请的评论区已被锁定")
+	}
+	if post.Status == models.StatusPending {
+		return nil, errors.New("该申请尚未公开，暂不能评论")
+	}
+	if !post.CanComment() {
+		return nil, errors.New("该申请已结束，不能再评论")
+	}
+
+	comment := &models.Comment{
+		PostID:      postID,
+		UserID:      userID,
+		Content:     content,
+		IsApplicant: post.UserID == userID,
+	}
+
+	if parentID != nil {
+		parent, err := s.commentRepo.FindByID(*parentID)
+		if err != nil || parent.PostID != postID {
+			return nil, errors.New("回复的评论不存在")
+		}
+		if parent.Deleted {
+			return nil, errors.New("不能回复已删除的评论")
+		}
+
+		// 所有回复都归属到顶层评论下，便于按楼层分页
+		rootID := parent.ID
+		if parent.RootID != nil {
+			rootID = *parent.RootID
+		}
+		comment.ParentID = &parent.ID
+		comment.RootID = &rootID
+	}
+
+	if err := s.commentRepo.Create(comment); err != nil {
+		return nil, errors.New("发表评论失败")
+	}
+	comment.User = user
+
+	s.refreshCount(postID)
+
+	return comment, nil
+}
+
+// Update 修改评论(仅作者本人在修改时间窗口内可修改)
+func (s *CommentService) Update(id, userID uint, content string) (*models.Comment, error) {
+	comment, err := s.getComment(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.UserID != userID {
+		return nil, errors.New("只能修改自己的评论")
+	}
+	if !comment.IsVisible() {
+		return nil, errors.New("该评论已被隐藏或删除，不能修改")
+	}
+
+	post, err := s.postRepo.FindByID(comment.PostID)
+	if err != nil {
+		return nil, errors.New("帖子不存在")
+	}
+	if post.CommentsLocked {
+		return nil, errors.New("该申请的评论区已被锁定")
+	}
+
+	editMinutes := s.configRepo.GetInt(models.ConfigCommentEditMinutes, models.DefaultCommentEditMinutes)
+	if !comment.WithinWindow(editMinutes) {
+		return nil, errors.New("已超过评论可修改的时间")
+	}
+
+	if err := s.commentRepo.UpdateContent(id, content); err != nil {
+		return nil, errors.New("修改评论失败")
+	}
+
+	return s.commentRepo.FindByID(id)
+}
+
+// Delete 删除评论(作者本人在删除时间窗口内可删除，管理员不受限制)
+func (s *CommentService) Delete(id, userID uint, isAdmin bool) error {
+	comment, err := s.getComment(id)
+	if err != nil {
+		return err
+	}
+
+	if comment.Deleted {
+		return errors.New("评论已被删除")
+	}
+
+	if !isAdmin {
+		if comment.UserID != userID {
+			return errors.New("只能删除自己的评论")
+		}
+		deleteMinutes := s.configRepo.GetInt(models.ConfigCommentDeleteMinutes, models.DefaultCommentDeleteMinutes)
+		if !comment.WithinWindow(deleteMinutes) {
+			return errors.New("已超过评论可删除的时间")
+		}
+	}
+
+	if err := s.commentRepo.MarkDeleted(id, userID); err != nil {
+		return errors.New("删除评论失败")
+	}
+
+	s.refreshCount(comment.PostID)
+
+	return nil
+}
+
+// SetHidden 隐藏或恢复评论(管理员)
+func (s *CommentService) SetHidden(id, operatorID uint, hidden bool) error {
+	comment, err := s.getComment(id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.commentRepo.SetHidden(id, hidden, operatorID); err != nil {
+		return errors.New("更新评论状态失败")
+	}
+
+	s.refreshCount(comment.PostID)
+
+	return nil
+}
+
+// SetLocked 锁定或解锁帖子评论区(管理员)
+func (s *CommentService) SetLocked(postID uint, locked bool) error {
+	if _, err := s.postRepo.FindByID(postID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("帖子不存在")
+		}
+		return err
+	}
+
+	if err := s.postRepo.SetCommentsLocked(postID, locked); err != nil {
+		return errors.New("更新评论区状态失败")
+	}
+	return nil
+}
+
+// getComment 获取评论
+func (s *CommentService) getComment(id uint) (*models.Comment, error) {
+	comment, err := s.commentRepo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("评论不存在")
+		}
+		return nil, err
+	}
+	return comment, nil
+}
+
+// refreshCount 重新统计帖子的可见评论数
+func (s *CommentService) refreshCount(postID uint) {
+	count, err := s.commentRepo.CountVisibleByPost(postID)
+	if err != nil {
+		return
+	}
+	_ = s.postRepo.UpdateCommentCount(postID, count)
+}
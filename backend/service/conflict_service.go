@@ -0,0 +1,190 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"linuxdo-review/models"
+	"linuxdo-review/repository"
+
+	"gorm.io/gorm"
+)
+
+// maxFingerprintLength 设备指纹的最大长度
+const maxFingerprintLength = 128
+
+// ConflictService 审核利益冲突服务
+type ConflictService struct {
+	conflictRepo *repository.ConflictRepository
+	voteRepo     *repository.VoteRepository
+	userRepo     *repository.UserRepository
+	configRepo   *repository.ConfigRepository
+}
+
+// NewConflictService 创建审核利益冲突服务
+func NewConflictService(
+	conflictRepo *repository.ConflictRepository,
+	voteRepo *repository.VoteRepository,
+	userRepo *repository.UserRepository,
+	configRepo *repository.ConfigRepository,
+) *ConflictService {
+	return &ConflictService{
+		conflictRepo: conflictRepo,
+		voteRepo:     voteRepo,
+		userRepo:     userRepo,
+		configRepo:   configRepo,
+	}
+}
+
+// RecordDevice 记录用户注册或登录时的IP和设备指纹
+func (s *ConflictService) RecordDevice(userID uint, ip, fingerprint string) {
+	fingerprint = strings.TrimSpace(fingerprint)
+	if len(fingerprint) > maxFingerprintLength {
+		fingerprint = fingerprint[:maxFingerprintLength]
+	}
+	if userID == 0 || (ip == "" && fingerprint == "") {
+		return
+	}
+	_ = s.conflictRepo.RecordDevice(userID, ip, fingerprint)
+}
+
+// Check 检查审核员与申请之间是否存在利益冲突，返回冲突原因(没有冲突时返回空)
+func (s *ConflictService) Check(reviewerID uint, post *models.Post) (string, error) {
+	conflicts, err := s.FindConflicts(reviewerID, []*models.Post{post})
+	if err != nil {
+		return "", err
+	}
+	return conflicts[post.ID], nil
+}
+
+// FindConflicts 批量检查审核员与多个申请之间的利益冲突，返回存在冲突的申请ID及原因
+// 每种规则只查询一次，与申请数量无关
+func (s *ConflictService) FindConflicts(reviewerID uint, posts []*models.Post) (map[uint]string, error) {
+	conflicts := make(map[uint]string)
+	if len(posts) == 0 {
+		return conflicts, nil
+	}
+
+	postIDs := make([]uint, 0, len(posts))
+	applicantIDs := make([]uint, 0, len(posts))
+	seen := make(map[uint]bool, len(posts))
+	for _, post := range posts {
+		postIDs = append(postIDs, post.ID)
+		if !seen[post.UserID] {
+			seen[post.UserID] = true
+			applicantIDs = append(applicantIDs, post.UserID)
+		}
+	}
+
+	// 按申请者记录的冲突原因
+	applicantReasons := make(map[uint]string)
+
+	if s.configRepo.GetBool(models.ConfigReviewConflictSharedDevice, false) {
+		days := s.configRepo.GetInt(models.ConfigReviewConflictDeviceDays, models.DefaultReviewConflictDeviceDays)
+		if days <= 0 {
+			days = models.DefaultReviewConflictDeviceDays
+		}
+		since := time.Now().AddDate(0, 0, -days)
+		matchIP := s.configRepo.GetBool(models.ConfigReviewConflictSharedIP, true)
+		ids, err := s.conflictRepo.ListSharedDeviceUserIDs(reviewerID, applicantIDs, since, matchIP)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			applicantReasons[id] = models.ConflictSharedDevice
+		}
+	}
+
+	if s.configRepo.GetBool(models.ConfigReviewConflictRelationship, true) {
+		ids, err := s.conflictRepo.ListRelatedUserIDs(reviewerID, applicantIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			applicantReasons[id] = models.ConflictRelationship
+		}
+	}
+
+	var voted map[uint]models.VoteType
+	if s.configRepo.GetBool(models.ConfigReviewConflictVoted, false) {
+		var err error
+		voted, err = s.voteRepo.GetUserVotesForPosts(reviewerID, postIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 同一申请命中多条规则时按 自己的申请 > 投过票 > 登记关系 > 相同设备 的顺序给出原因
+	for _, post := range posts {
+		if post.UserID == reviewerID {
+			conflicts[post.ID] = models.ConflictOwnPost
+		} else if _, ok := voted[post.ID]; ok {
+			conflicts[post.ID] = models.ConflictVoted
+		} else if reason, ok := applicantReasons[post.UserID]; ok {
+			conflicts[post.ID] = reason
+		}
+	}
+	return conflicts, nil
+}
+
+// ListRelationships 获取用户关系列表
+func (s *ConflictService) ListRelationships(userID uint, page, pageSize int) ([]*models.UserRelationship, int64, error) {
+	offset := (page - 1) * pageSize
+	return s.conflictRepo.ListRelationships(userID, offset, pageSize)
+}
+
+// CreateRelationship 登记两名用户之间的关系
+func (s *ConflictService) CreateRelationship(userID, relatedUserID, adminID uint, note string) (*models.UserRelationship, error) {
+	if userID == relatedUserID {
+		return nil, errors.New("不能登记用户与自己的关系")
+	}
+	for _, id := range []uint{userID, relatedUserID} {
+		if _, err := s.userRepo.FindByID(id); err != nil {
+			return nil, errors.New("用户不存在")
+		}
+	}
+	if related, err := s.conflictRepo.HasRelationship(userID, relatedUserID); err != nil {
+		return nil, err
+	} else if related {
+		return nil, errors.New("该关系已登记")
+	}
+
+	rel := &models.UserRelationship{
+		UserID:        userID,
+		RelatedUserID: relatedUserID,
+		Note:          note,
+		CreatedBy:     adminID,
+	}
+	if err := s.conflictRepo.CreateRelationship(rel); err != nil {
+		return nil, errors.New("登记关系失败")
+	}
+	return rel, nil
+}
+
+// DeleteRelationship 删除用户关系
+func (s *ConflictService) DeleteRelationship(id uint) error {
+	if err := s.conflictRepo.DeleteRelationship(id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("关系不存在")
+		}
+		return err
+	}
+	return nil
+}
+
+// ConflictText 利益冲突原因的说明
+func ConflictText(reason string) string {
+	switch reason {
+	case models.ConflictOwnPost:
+		return "不能审核自己的申请"
+	case models.ConflictVoted:
+		return "您在投票阶段对该申请投过票"
+	case models.ConflictRelationship:
+		return "您与申请者存在登记的关系"
+	case models.ConflictSharedDevice:
+		return "您与申请者近期使用过相同的设备"
+	default:
+		return reason
+	}
+}
@@ -0,0 +1,276 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"linuxdo-review/dto"
+	"linuxdo-review/models"
+	"linuxdo-review/repository"
+
+	"gorm.io/gorm"
+)
+
+var linkPattern = regexp.MustCompile(`(?i)\bhttps?://[^\s)\]>]+`)
+
+// ContentRuleService 内容规则服务
+type ContentRuleService struct {
+	ruleRepo *repository.ContentRuleRepository
+}
+
+// NewContentRuleService 创建内容规则服务
+func NewContentRuleService(ruleRepo *repository.ContentRuleRepository) *ContentRuleService {
+	return &ContentRuleService{
+		ruleRepo: ruleRepo,
+	}
+}
+
+// List 获取所有规则
+func (s *ContentRuleService) List() ([]*models.ContentRule, error) {
+	return s.ruleRepo.List()
+}
+
+// Create 创建规则
+func (s *ContentRuleService) Create(adminID uint, req *dto.ContentRuleRequest) (*models.ContentRule, error) {
+	rule := &models.ContentRule{CreatedBy: adminID, Enabled: true}
+	applyRuleRequest(rule, req)
+	if err := validateRule(rule); err != nil {
+		return nil, err
+	}
+
+	if err := s.ruleRepo.Create(rule); err != nil {
+		return nil, errors.New("创建规则失败")
+	}
+	return rule, nil
+}
+
+// Update 更新规则
+func (s *ContentRuleService) Update(id uint, req *dto.ContentRuleRequest) (*models.ContentRule, error) {
+	rule, err := s.ruleRepo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("规则不存在")
+		}
+		return nil, err
+	}
+
+	applyRuleRequest(rule, req)
+	if err := validateRule(rule); err != nil {
+		return nil, err
+	}
+
+	if err := s.ruleRepo.Update(rule); err != nil {
+		return nil, errors.New("更新规则失败")
+	}
+	return rule, nil
+}
+
+// Delete 删除规则
+func (s *ContentRuleService) Delete(id uint) error {
+	if _, err := s.ruleRepo.FindByID(id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("规则不存在")
+		}
+		return err
+	}
+	return s.ruleRepo.Delete(id)
+}
+
+// Test 试运行规则，不填规则时使用所有已启用的规则
+func (s *ContentRuleService) Test(req *dto.TestContentRulesRequest) (*dto.ContentRuleTestResponse, error) {
+	if req.Rule == nil {
+		return s.Evaluate(req.Title, req.Content)
+	}
+
+	rule := &models.ContentRule{Enabled: true}
+	applyRuleRequest(rule, req.Rule)
+	if err := validateRule(rule); err != nil {
+		return nil, err
+	}
+	return evaluateRules([]*models.ContentRule{rule}, req.Title, req.Content), nil
+}
+
+// Evaluate 使用所有已启用的规则检查申请内容
+func (s *ContentRuleService) Evaluate(title, content string) (*dto.ContentRuleTestResponse, error) {
+	rules, err := s.ruleRepo.ListEnabled()
+	if err != nil {
+		return nil, err
+	}
+	return evaluateRules(rules, title, content), nil
+}
+
+// evaluateRules 逐条检查规则，最终处理方式取命中规则中最严格的一个(拒绝 > 暂停 > 标记)
+func evaluateRules(rules []*models.ContentRule, title, content string) *dto.ContentRuleTestResponse {
+	text := title + "\n" + content
+	result := &dto.ContentRuleTestResponse{Hits: []dto.ContentRuleHit{}}
+	for _, rule := range rules {
+		detail, hit := matchRule(rule, text)
+		if !hit {
+			continue
+		}
+		result.Hits = append(result.Hits, dto.ContentRuleHit{
+			RuleID:  rule.ID,
+			Name:    rule.Name,
+			Action:  rule.Action,
+			Message: rule.Message,
+			Detail:  detail,
+		})
+		if actionSeverity(rule.Action) > actionSeverity(result.Action) {
+			result.Action = rule.Action
+		}
+	}
+	return result
+}
+
+// matchRule 检查单条规则，返回命中的具体内容
+func matchRule(rule *models.ContentRule, text string) (string, bool) {
+	switch rule.Type {
+	case models.RuleTypeRegex:
+		re, err := regexp.Compile(rule.Pattern)
+		if err != nil {
+			return "", false
+		}
+		if loc := re.FindStringIndex(text); loc != nil {
+			return fmt.Sprintf("匹配到 %q", text[loc[0]:loc[1]]), true
+		}
+	case models.RuleTypeKeywords:
+		lower := strings.ToLower(text)
+		for _, keyword := range ruleKeywords(rule.Pattern) {
+			if strings.Contains(lower, strings.ToLower(keyword)) {
+				return fmt.Sprintf("包含关键词 %q", keyword), true
+			}
+		}
+	case models.RuleTypeDistinctChars:
+		distinct := make(map[rune]bool)
+		for _, r := range text {
+			if !unicode.IsSpace(r) {
+				distinct[r] = true
+			}
+		}
+		if float64(len(distinct)) < rule.Threshold {
+			return fmt.Sprintf("仅包含 %d 个不同字符", len(distinct)), true
+		}
+	case models.RuleTypeLinkCount:
+		count := len(linkPattern.FindAllString(text, -1))
+		if float64(count) > rule.Threshold {
+			return fmt.Sprintf("包含 %d 个链接", count), true
+		}
+	case models.RuleTypeLanguageRatio:
+		ratio := languageRatio(text, rule.Pattern)
+		if ratio < rule.Threshold {
+			return fmt.Sprintf("%s占比 %.1f%%", languageName(rule.Pattern), ratio), true
+		}
+	}
+	return "", false
+}
+
+// languageRatio 计算指定文字在所有字母中的占比(百分比)
+func languageRatio(text, language string) float64 {
+	table := unicode.Latin
+	if language == models.RuleLanguageHan {
+		table = unicode.Han
+	}
+
+	var letters, matched int
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		letters++
+		if unicode.Is(table, r) {
+			matched++
+		}
+	}
+	if letters == 0 {
+		return 0
+	}
+	return float64(matched) / float64(letters) * 100
+}
+
+func languageName(language string) string {
+	if language == models.RuleLanguageHan {
+		return "汉字"
+	}
+	return "拉丁字母"
+}
+
+// ruleKeywords 解析关键词列表(每行一个)
+func ruleKeywords(pattern string) []string {
+	var keywords []string
+	for _, line := range strings.Split(pattern, "\n") {
+		if keyword := strings.TrimSpace(line); keyword != "" {
+			keywords = append(keywords, keyword)
+		}
+	}
+	return keywords
+}
+
+func actionSeverity(action models.ContentRuleAction) int {
+	switch action {
+	case models.RuleActionReject:
+		return 3
+	case models.RuleActionHold:
+		return 2
+	case models.RuleActionFlag:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func applyRuleRequest(rule *models.ContentRule, req *dto.ContentRuleRequest) {
+	rule.Name = strings.TrimSpace(req.Name)
+	rule.Type = req.Type
+	rule.Pattern = req.Pattern
+	rule.Threshold = req.Threshold
+	rule.Action = req.Action
+	rule.Message = strings.TrimSpace(req.Message)
+	if req.Enabled != nil {
+		rule.Enabled = *req.Enabled
+	}
+}
+
+// validateRule 校验规则配置
+func validateRule(rule *models.ContentRule) error {
+	if rule.Name == "" {
+		return errors.New("规则名称不能为空")
+	}
+	if actionSeverity(rule.Action) == 0 {
+		return errors.New("无效的处理方式")
+	}
+
+	switch rule.Type {
+	case models.RuleTypeRegex:
+		if rule.Pattern == "" {
+			return errors.New("正则表达式不能为空")
+		}
+		if _, err := regexp.Compile(rule.Pattern); err != nil {
+			return fmt.Errorf("正则表达式无效: %v", err)
+		}
+	case models.RuleTypeKeywords:
+		if len(ruleKeywords(rule.Pattern)) == 0 {
+			return errors.New("关键词列表不能为空")
+		}
+	case models.RuleTypeDistinctChars:
+		if rule.Threshold <= 0 {
+			return errors.New("不同字符数阈值必须大于0")
+		}
+	case models.RuleTypeLinkCount:
+		if rule.Threshold < 0 {
+			return errors.New("链接数阈值不能小于0")
+		}
+	case models.RuleTypeLanguageRatio:
+		if rule.Pattern != models.RuleLanguageHan && rule.Pattern != models.RuleLanguageLatin {
+			return errors.New("语言只能是 han 或 latin")
+		}
+		if rule.Threshold <= 0 || rule.Threshold > 100 {
+			return errors.New("语言占比阈值必须在0到100之间")
+		}
+	default:
+		return errors.New("无效的规则类型")
+	}
+	return nil
+}
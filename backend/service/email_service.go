@@ -138,6 +138,50 @@ func (s *EmailService) SendRejectionNotification(to, username, postTitle, reason
 	return nil
 }
 
+// SendAppealDecision 发送申诉处理结果邮件
+func (s *EmailService) SendAppealDecision(to, username, postTitle string, accepted bool, note string) error {
+	if !s.enabled {
+		log.Printf("[EmailService] SMTP未配置,跳过发送申诉结果邮件给 %s", to)
+		return nil
+	}
+
+	subject := "关于您的申诉处理结果"
+
+	resultText := "很遗憾，您的申诉未被接受，原拒绝决定维持不变。"
+	if accepted {
+		resultText = "您的申诉已被接受，申请已重新开启审核，请留意后续进展。"
+	}
+
+	noteText := "无"
+	if note != "" {
+		noteText = note
+	}
+
+	body := fmt.Sprintf(`亲爱的 %s：
+
+您针对邀请码申请「%s」提交的申诉已处理完毕。
+
+%s
+
+━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
+处理说明：%s
+━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
+
+如有疑问，请联系管理员。
+
+---
+此邮件由Linux.do Review系统自动发送，请勿回复。
+`, username, postTitle, resultText, noteText)
+
+	if err := s.send(to, subject, body); err != nil {
+		log.Printf("[EmailService] 发送申诉结果邮件失败: %v", err)
+		return err
+	}
+
+	log.Printf("[EmailService] 申诉结果邮件已发送给 %s", to)
+	return nil
+}
+
 // SendStatusNotification 发送状态变更通知邮件
 func (s *EmailService) SendStatusNotification(to, username, postTitle, statusText, message string) error {
 	if !s.enabled {
@@ -0,0 +1,219 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"linuxdo-review/models"
+	"linuxdo-review/repository"
+
+	"gorm.io/gorm"
+)
+
+const (
+	maxFormQuestions      = 30   // 申请表最多问题数
+	maxAnswerLength       = 5000 // 单个回答默认最大字符数
+	minFreeContentLength  = 50   // 未使用申请表时申请内容的最少字符数
+	maxChoiceOptionLength = 100
+)
+
+var formKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,49}$`)
+
+// FormService 申请表服务
+type FormService struct {
+	formRepo *repository.FormRepository
+}
+
+// NewFormService 创建申请表服务
+func NewFormService(formRepo *repository.FormRepository) *FormService {
+	return &FormService{formRepo: formRepo}
+}
+
+// GetCurrent 获取当前生效的申请表(未配置时返回nil)
+func (s *FormService) GetCurrent() (*models.ApplicationForm, error) {
+	return s.formRepo.FindLatest()
+}
+
+// GetVersion 获取指定版本的申请表
+func (s *FormService) GetVersion(version int) (*models.ApplicationForm, error) {
+	form, err := s.formRepo.FindByVersion(version)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("申请表版本不存在")
+		}
+		return nil, err
+	}
+	return form, nil
+}
+
+// ListVersions 获取所有申请表版本
+func (s *FormService) ListVersions() ([]*models.ApplicationForm, error) {
+	return s.formRepo.List()
+}
+
+// Publish 发布新版本的申请表(问题列表为空表示恢复自由填写)
+func (s *FormService) Publish(adminID uint, questions []models.FormQuestion) (*models.ApplicationForm, error) {
+	if err := validateQuestions(questions); err != nil {
+		return nil, err
+	}
+
+	latest, err := s.formRepo.FindLatest()
+	if err != nil {
+		return nil, errors.New("获取申请表失败")
+	}
+	version := 1
+	if latest != nil {
+		version = latest.Version + 1
+	}
+
+	form := &models.ApplicationForm{
+		Version:   version,
+		Questions: questions,
+		CreatedBy: adminID,
+	}
+	if err := s.formRepo.Create(form); err != nil {
+		return nil, errors.New("发布申请表失败")
+	}
+	return form, nil
+}
+
+// BuildAnswers 按申请表校验回答，返回按问题顺序排列的回答
+func (s *FormService) BuildAnswers(form *models.ApplicationForm, values map[string]string) ([]models.PostAnswer, error) {
+	known := make(map[string]bool, len(form.Questions))
+	answers := make([]models.PostAnswer, 0, len(form.Questions))
+
+	for _, q := range form.Questions {
+		known[q.Key] = true
+		value := strings.TrimSpace(values[q.Key])
+
+		if value == "" {
+			if q.Required {
+				return nil, fmt.Errorf("请回答「%s」", q.Label)
+			}
+			continue
+		}
+
+		if err := validateAnswer(&q, value); err != nil {
+			return nil, err
+		}
+
+		answers = append(answers, models.PostAnswer{
+			Key:   q.Key,
+			Label: q.Label,
+			Type:  q.Type,
+			Value: value,
+		})
+	}
+
+	for key := range values {
+		if !known[key] {
+			return nil, fmt.Errorf("申请表中不存在问题 %s", key)
+		}
+	}
+
+	return answers, nil
+}
+
+// RenderAnswers 将申请表回答渲染为申请内容文本(用于修订对比和搜索)
+func RenderAnswers(answers []models.PostAnswer) string {
+	var b strings.Builder
+	for i, a := range answers {
+		if i > 0 {
+			b.WriteString("\n\n")
+		}
+		b.WriteString("【")
+		b.WriteString(a.Label)
+		b.WriteString("】\n")
+		b.WriteString(a.Value)
+	}
+	return b.String()
+}
+
+// validateQuestions 校验申请表问题定义
+func validateQuestions(questions []models.FormQuestion) error {
+	if len(questions) > maxFormQuestions {
+		return fmt.Errorf("申请表最多包含 %d 个问题", maxFormQuestions)
+	}
+
+	keys := make(map[string]bool, len(questions))
+	for i := range questions {
+		q := &questions[i]
+		q.Key = strings.TrimSpace(q.Key)
+		q.Label = strings.TrimSpace(q.Label)
+
+		if !formKeyPattern.MatchString(q.Key) {
+			return fmt.Errorf("问题标识 %q 无效(需以小写字母开头，只能包含小写字母、数字和下划线)", q.Key)
+		}
+		if keys[q.Key] {
+			return fmt.Errorf("问题标识 %s 重复", q.Key)
+		}
+		keys[q.Key] = true
+
+		if q.Label == "" {
+			return fmt.Errorf("问题 %s 缺少标题", q.Key)
+		}
+		if q.MinLength < 0 || q.MaxLength < 0 || q.MaxLength > maxAnswerLength {
+			return fmt.Errorf("问题 %s 的长度限制无效", q.Key)
+		}
+		if q.MaxLength > 0 && q.MinLength > q.MaxLength {
+			return fmt.Errorf("问题 %s 的最少字符数不能大于最多字符数", q.Key)
+		}
+
+		switch q.Type {
+		case models.FormQuestionText, models.FormQuestionURL:
+			q.Options = nil
+		case models.FormQuestionChoice:
+			if len(q.Options) < 2 {
+				return fmt.Errorf("单选题 %s 至少需要两个选项", q.Key)
+			}
+			seen := make(map[string]bool, len(q.Options))
+			for j, opt := range q.Options {
+				opt = strings.TrimSpace(opt)
+				if opt == "" || utf8.RuneCountInString(opt) > maxChoiceOptionLength || seen[opt] {
+					return fmt.Errorf("单选题 %s 的选项无效或重复", q.Key)
+				}
+				seen[opt] = true
+				q.Options[j] = opt
+			}
+			q.MinLength, q.MaxLength = 0, 0
+		default:
+			return fmt.Errorf("问题 %s 的类型无效(可选 text、choice、url)", q.Key)
+		}
+	}
+	return nil
+}
+
+// validateAnswer 校验单个回答
+func validateAnswer(q *models.FormQuestion, value string) error {
+	switch q.Type {
+	case models.FormQuestionChoice:
+		for _, opt := range q.Options {
+			if opt == value {
+				return nil
+			}
+		}
+		return fmt.Errorf("「%s」的选项无效", q.Label)
+	case models.FormQuestionURL:
+		u, err := url.ParseRequestURI(value)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("「%s」需要填写有效的 http(s) 链接", q.Label)
+		}
+	}
+
+	maxLength := q.MaxLength
+	if maxLength == 0 {
+		maxLength = maxAnswerLength
+	}
+	length := utf8.RuneCountInString(value)
+	if length < q.MinLength {
+		return fmt.Errorf("「%s」至少需要 %d 个字符", q.Label, q.MinLength)
+	}
+	if length > maxLength {
+		return fmt.Errorf("「%s」最多 %d 个字符", q.Label, maxLength)
+	}
+	return nil
+}
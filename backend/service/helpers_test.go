@@ -0,0 +1,100 @@
+package service
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"linuxdo-review/config"
+	"linuxdo-review/database"
+	"linuxdo-review/models"
+	"linuxdo-review/repository"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// newTestDB 创建独立的临时数据库(已完成表结构迁移)
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "test.db")), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	if err := database.Migrate(db); err != nil {
+		t.Fatalf("migrate database: %v", err)
+	}
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	})
+	return db
+}
+
+// newTestPostService 创建使用测试数据库的帖子服务(票数门槛足够高，投票不会改变帖子状态)
+func newTestPostService(db *gorm.DB) *PostService {
+	postRepo := repository.NewPostRepository(db)
+	voteRepo := repository.NewVoteRepository(db)
+	configRepo := repository.NewConfigRepository(db)
+	eventRepo := repository.NewPostEventRepository(db)
+	cfg := &config.Config{Review: config.ReviewConfig{MinVotes: 1000, ApprovalRate: 70}}
+	return NewPostService(
+		postRepo, voteRepo, configRepo, repository.NewUserRepository(db),
+		repository.NewRevisionRepository(db), eventRepo,
+		NewApplyPolicyService(postRepo, configRepo, repository.NewExemptionRepository(db)),
+		NewFormService(repository.NewFormRepository(db)),
+		NewSimilarityService(repository.NewSimilarityRepository(db), postRepo, configRepo, eventRepo),
+		NewContentRuleService(repository.NewContentRuleRepository(db)),
+		NewReputationService(repository.NewReputationRepository(db), voteRepo, configRepo),
+		repository.NewReviewDecisionRepository(db), repository.NewReviewLockRepository(db),
+		repository.NewVoteClusterRepository(db), cfg,
+	)
+}
+
+// setConfig 写入系统配置
+func setConfig(t *testing.T, db *gorm.DB, key string, value interface{}) {
+	t.Helper()
+	if err := repository.NewConfigRepository(db).Set(key, fmt.Sprint(value), ""); err != nil {
+		t.Fatalf("set config %s: %v", key, err)
+	}
+}
+
+// createUser 创建已绑定 Linux.do 且注册已满一年的用户
+func createUser(t *testing.T, db *gorm.DB, name string, trustLevel int, role models.UserRole) *models.User {
+	t.Helper()
+	user := &models.User{
+		Email:      name + "@example.com",
+		Username:   name,
+		Role:       role,
+		LinuxDoID:  "ld-" + name,
+		TrustLevel: trustLevel,
+		CreatedAt:  time.Now().AddDate(-1, 0, 0),
+	}
+	if err := db.Create(user).Error; err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+	return user
+}
+
+// createVotingPost 创建处于社区投票阶段的帖子
+func createVotingPost(t *testing.T, db *gorm.DB, authorID uint) *models.Post {
+	t.Helper()
+	post := &models.Post{UserID: authorID, Title: "测试申请", Content: "测试内容", Status: models.StatusFirstReview}
+	if err := db.Create(post).Error; err != nil {
+		t.Fatalf("create post: %v", err)
+	}
+	return post
+}
+
+// findVote 获取用户在帖子上的投票
+func findVote(t *testing.T, db *gorm.DB, postID, userID uint) *models.Vote {
+	t.Helper()
+	vote, err := repository.NewVoteRepository(db).FindByPostAndUser(postID, userID)
+	if err != nil {
+		t.Fatalf("find vote: %v", err)
+	}
+	return vote
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"linuxdo-review/models"
+	"linuxdo-review/repository"
+
+	"gorm.io/gorm"
+)
+
+// ModerationService 预审服务(新申请进入社区投票前的人工筛查)
+type ModerationService struct {
+	postRepo      *repository.PostRepository
+	configRepo    *repository.ConfigRepository
+	eventRepo     *repository.PostEventRepository
+	reviewService *ReviewService
+}
+
+// NewModerationService 创建预审服务
+func NewModerationService(
+	postRepo *repository.PostRepository,
+	configRepo *repository.ConfigRepository,
+	eventRepo *repository.PostEventRepository,
+	reviewService *ReviewService,
+) *ModerationService {
+	return &ModerationService{
+		postRepo:      postRepo,
+		configRepo:    configRepo,
+		eventRepo:     eventRepo,
+		reviewService: reviewService,
+	}
+}
+
+// ListQueue 分页获取预审队列
+func (s *ModerationService) ListQueue(page, pageSize int) ([]*models.Post, int64, error) {
+	offset := (page - 1) * pageSize
+	return s.postRepo.ListPendingQueue(offset, pageSize)
+}
+
+// Release 放行待审核的申请到社区投票
+func (s *ModerationService) Release(postID, operatorID uint) error {
+	if err := s.reviewService.checkReviewer(operatorID); err != nil {
+		return err
+	}
+
+	if err := s.postRepo.Release(postID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("帖子不存在或不在待审核状态")
+		}
+		return errors.New("放行失败")
+	}
+	_ = s.eventRepo.Record(postID, operatorID, models.PostEventRelease, "")
+
+	return nil
+}
+
+// Reject 在预审阶段直接拒绝申请(会发送拒绝通知邮件)
+func (s *ModerationService) Reject(postID, operatorID uint, reason string) error {
+	if err := s.reviewService.checkReviewer(operatorID); err != nil {
+		return err
+	}
+
+	post, err := s.postRepo.FindByID(postID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("帖子不存在")
+		}
+		return err
+	}
+	if post.Status != models.StatusPending {
+		return errors.New("帖子不在待审核状态")
+	}
+
+	if reason == "" {
+		reason = "未通过预审"
+	}
+	return s.reviewService.RejectWithNotification(postID, operatorID, reason)
+}
+
+// AutoReleaseExpired 自动放行超过预审时限的申请，返回放行数量
+func (s *ModerationService) AutoReleaseExpired() (int, error) {
+	hours := s.configRepo.GetInt(models.ConfigModerationAutoReleaseHours, models.DefaultModerationAutoReleaseHours)
+	if hours <= 0 {
+		return 0, nil
+	}
+
+	ids, err := s.postRepo.ListExpiredPending(time.Now().Add(-time.Duration(hours) * time.Hour))
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	note := fmt.Sprintf("预审超过 %d 小时未处理，自动放行", hours)
+	for _, id := range ids {
+		// 期间可能已被人工处理
+		if err := s.postRepo.Release(id); err != nil {
+			continue
+		}
+		_ = s.eventRepo.Record(id, 0, models.PostEventRelease, note)
+		count++
+	}
+	return count, nil
+}
+
+// StartAutoRelease 启动后台定时自动放行
+func (s *ModerationService) StartAutoRelease(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			count, err := s.AutoReleaseExpired()
+			if err != nil {
+				log.Printf("[ModerationService] 自动放行失败: %v", err)
+				continue
+			}
+			if count > 0 {
+				log.Printf("[ModerationService] 已自动放行 %d 个申请", count)
+			}
+		}
+	}()
+}
@@ -2,11 +2,17 @@ package service
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"linuxdo-review/config"
 	"linuxdo-review/dto"
 	"linuxdo-review/models"
+	"linuxdo-review/pkg/markdown"
+	"linuxdo-review/pkg/textdiff"
 	"linuxdo-review/repository"
 
 	"gorm.io/gorm"
@@ -14,11 +20,21 @@ import (
 
 // PostService 帖子服务
 type PostService struct {
-	postRepo   *repository.PostRepository
-	voteRepo   *repository.VoteRepository
-	configRepo *repository.ConfigRepository
-	userRepo   *repository.UserRepository
-	cfg        *config.Config
+	postRepo     *repository.PostRepository
+	voteRepo     *repository.VoteRepository
+	configRepo   *repository.ConfigRepository
+	userRepo     *repository.UserRepository
+	revisionRepo *repository.RevisionRepository
+	eventRepo    *repository.PostEventRepository
+	applyPolicy  *ApplyPolicyService
+	formService  *FormService
+	similarity   *SimilarityService
+	contentRules *ContentRuleService
+	reputation   *ReputationService
+	decisionRepo *repository.ReviewDecisionRepository
+	lockRepo     *repository.ReviewLockRepository
+	clusterRepo  *repository.VoteClusterRepository
+	cfg          *config.Config
 }
 
 // NewPostService 创建帖子服务
@@ -27,14 +43,34 @@ func NewPostService(
 	voteRepo *repository.VoteRepository,
 	configRepo *repository.ConfigRepository,
 	userRepo *repository.UserRepository,
+	revisionRepo *repository.RevisionRepository,
+	eventRepo *repository.PostEventRepository,
+	applyPolicy *ApplyPolicyService,
+	formService *FormService,
+	similarity *SimilarityService,
+	contentRules *ContentRuleService,
+	reputation *ReputationService,
+	decisionRepo *repository.ReviewDecisionRepository,
+	lockRepo *repository.ReviewLockRepository,
+	clusterRepo *repository.VoteClusterRepository,
 	cfg *config.Config,
 ) *PostService {
 	return &PostService{
-		postRepo:   postRepo,
-		voteRepo:   voteRepo,
-		configRepo: configRepo,
-		userRepo:   userRepo,
-		cfg:        cfg,
+		postRepo:     postRepo,
+		voteRepo:     voteRepo,
+		configRepo:   configRepo,
+		userRepo:     userRepo,
+		revisionRepo: revisionRepo,
+		eventRepo:    eventRepo,
+		applyPolicy:  applyPolicy,
+		formService:  formService,
+		similarity:   similarity,
+		contentRules: contentRules,
+		reputation:   reputation,
+		decisionRepo: decisionRepo,
+		lockRepo:     lockRepo,
+		clusterRepo:  clusterRepo,
+		cfg:          cfg,
 	}
 }
 
@@ -58,6 +94,17 @@ func (s *PostService) Create(userID uint, req *dto.CreatePostRequest) (*models.P
 		return nil, errors.New("您已有进行中的申请，请等待审核完成后再发起新申请")
 	}
 
+	// 检查重新申请冷却期和申请次数上限
+	if err := s.applyPolicy.Check(userID); err != nil {
+		return nil, err
+	}
+
+	// 配置了申请表时按问题填写，否则使用自由填写的内容
+	form, err := s.formService.GetCurrent()
+	if err != nil {
+		return nil, errors.New("获取申请表失败")
+	}
+
 	post := &models.Post{
 		UserID:  userID,
 		Title:   req.Title,
@@ -65,13 +112,314 @@ func (s *PostService) Create(userID uint, req *dto.CreatePostRequest) (*models.P
 		Status:  models.StatusFirstReview, // 默认进入一级审核(社区投票)
 	}
 
-	if err := s.postRepo.Create(post); err != nil {
+	if form.HasQuestions() {
+		answers, err := s.formService.BuildAnswers(form, req.Answers)
+		if err != nil {
+			return nil, err
+		}
+		post.FormVersion = form.Version
+		post.Answers = answers
+		post.Content = RenderAnswers(answers)
+	} else if utf8.RuneCountInString(strings.TrimSpace(req.Content)) < minFreeContentLength {
+		return nil, fmt.Errorf("申请内容至少需要 %d 个字符", minFreeContentLength)
+	}
+
+	// 内容规则检查: 拒绝类直接拒绝提交，暂停类进入待审核，标记类仅记录
+	ruleResult, err := s.contentRules.Evaluate(post.Title, post.Content)
+	if err != nil {
+		return nil, errors.New("检查申请内容失败")
+	}
+	var holdRules, flagRules []string
+	for _, hit := range ruleResult.Hits {
+		switch hit.Action {
+		case models.RuleActionReject:
+			if hit.Message != "" {
+				return nil, errors.New(hit.Message)
+			}
+			return nil, fmt.Errorf("申请内容不符合要求: %s", hit.Name)
+		case models.RuleActionHold:
+			holdRules = append(holdRules, hit.Name)
+		case models.RuleActionFlag:
+			flagRules = append(flagRules, hit.Name)
+		}
+	}
+	post.RuleFlags = strings.Join(flagRules, ",")
+	post.ContentHTML = s.renderContent(post.Content)
+
+	// 开启预审或命中暂停类规则时新申请先进入待审核，由管理员或认证用户放行后才公开投票
+	// status 字段有数据库默认值，零值的待审核状态无法在创建时写入，因此在同一事务中创建后立即暂停，
+	// 避免帖子在暂停前被公开投票或暂停失败后跳过预审
+	holdReason := ""
+	if len(holdRules) > 0 {
+		holdReason = "命中内容规则: " + strings.Join(holdRules, ",")
+	}
+	hold := holdReason != "" || s.configRepo.GetBool(models.ConfigModerationEnabled, false)
+
+	err = s.postRepo.Transaction(func(tx *gorm.DB) error {
+		postRepo := s.postRepo.WithTx(tx)
+		if err := postRepo.Create(post); err != nil {
+			return err
+		}
+		if !hold {
+			return nil
+		}
+		return postRepo.Hold(post.ID, holdReason)
+	})
+	if err != nil {
 		return nil, errors.New("创建帖子失败")
 	}
+	if hold {
+		now := time.Now()
+		post.Status = models.StatusPending
+		post.HeldAt = &now
+		post.HoldReason = holdReason
+	}
+
+	// 记录原始版本
+	_ = s.revisionRepo.Create(&models.PostRevision{
+		PostID:   post.ID,
+		Version:  1,
+		EditorID: userID,
+		Title:    post.Title,
+		Content:  post.Content,
+	})
+	_ = s.eventRepo.Record(post.ID, userID, models.PostEventCreate, "")
+	if holdReason != "" {
+		_ = s.eventRepo.Record(post.ID, 0, models.PostEventHold, holdReason)
+	}
+	if post.RuleFlags != "" {
+		_ = s.eventRepo.Record(post.ID, 0, models.PostEventFlag, "命中内容规则: "+post.RuleFlags)
+	}
+
+	// 重复检测失败不影响提交申请
+	_, _ = s.similarity.Check(post)
 
 	return post, nil
 }
 
+// Update 修改帖子(仅申请者本人在投票阶段可以修改，每次修改都会生成修订记录)
+func (s *PostService) Update(postID, userID uint, req *dto.UpdatePostRequest) (*models.Post, error) {
+	post, err := s.postRepo.FindByID(postID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("帖子不存在")
+		}
+		return nil, err
+	}
+
+	if post.UserID != userID {
+		return nil, errors.New("只能修改自己的申请")
+	}
+
+	if !post.CanEdit() {
+		return nil, errors.New("只有社区投票中的申请可以修改")
+	}
+
+	title := post.Title
+	if req.Title != "" {
+		title = req.Title
+	}
+	content := post.Content
+	var answers []models.PostAnswer
+	if post.FormVersion > 0 {
+		// 使用申请表填写的申请只能修改回答，按原版本的问题校验
+		if req.Content != "" {
+			return nil, errors.New("该申请按申请表填写，请修改对应问题的回答")
+		}
+		if req.Answers != nil {
+			form, err := s.formService.GetVersion(post.FormVersion)
+			if err != nil {
+				return nil, err
+			}
+			values := make(map[string]string, len(post.Answers))
+			for _, a := range post.Answers {
+				values[a.Key] = a.Value
+			}
+			for key, value := range req.Answers {
+				values[key] = value
+			}
+			answers, err = s.formService.BuildAnswers(form, values)
+			if err != nil {
+				return nil, err
+			}
+			content = RenderAnswers(answers)
+		}
+	} else if req.Content != "" {
+		content = req.Content
+	}
+	if title == post.Title && content == post.Content {
+		return nil, errors.New("内容未发生变化")
+	}
+
+	latest, err := s.revisionRepo.LatestVersion(postID)
+	if err != nil {
+		return nil, errors.New("获取修订记录失败")
+	}
+
+	// 判断是否为实质性修改
+	changeRate := textdiff.ChangeRate(post.Title+"\n"+post.Content, title+"\n"+content)
+	substantialRate := s.configRepo.GetInt(models.ConfigEditSubstantialRate, models.DefaultEditSubstantialRate)
+	substantial := changeRate >= float64(substantialRate)
+
+	votePolicy := models.EditVotePolicyNone
+	if substantial {
+		votePolicy = s.configRepo.GetString(models.ConfigEditVotePolicy, models.DefaultEditVotePolicy)
+	}
+
+	revision := &models.PostRevision{
+		PostID:      postID,
+		Version:     latest + 1,
+		EditorID:    userID,
+		Title:       title,
+		Content:     content,
+		ChangeRate:  changeRate,
+		Substantial: substantial,
+		VotePolicy:  votePolicy,
+	}
+	contentHTML := s.renderContent(content)
+
+	// 修改内容、记录修订版本和处理已有投票在同一事务中完成，
+	// 并发修改导致版本号冲突时整体回滚
+	err = s.postRepo.Transaction(func(tx *gorm.DB) error {
+		postRepo := s.postRepo.WithTx(tx)
+		revisionRepo := s.revisionRepo.WithTx(tx)
+		voteRepo := s.voteRepo.WithTx(tx)
+
+		// 功能上线前创建的帖子没有原始版本，先补录
+		if latest == 0 {
+			original := &models.PostRevision{
+				PostID:    postID,
+				Version:   1,
+				EditorID:  post.UserID,
+				Title:     post.Title,
+				Content:   post.Content,
+				CreatedAt: post.CreatedAt,
+			}
+			if err := revisionRepo.Create(original); err != nil {
+				return err
+			}
+			revision.Version = 2
+		}
+
+		if answers != nil {
+			if err := postRepo.UpdateAnswers(postID, answers); err != nil {
+				return err
+			}
+		}
+		if err := postRepo.UpdateContent(postID, title, content, contentHTML); err != nil {
+			return err
+		}
+		if err := revisionRepo.Create(revision); err != nil {
+			return err
+		}
+
+		// 实质性修改后按配置处理已有投票
+		switch votePolicy {
+		case models.EditVotePolicyFlag:
+			return voteRepo.MarkStaleByPost(postID)
+		case models.EditVotePolicyReset:
+			if err := voteRepo.DeleteByPost(postID); err != nil {
+				return err
+			}
+			return postRepo.UpdateVotes(postID, 0, 0)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.New("修改帖子失败，请稍后重试")
+	}
+	_ = s.eventRepo.Record(postID, userID, models.PostEventEdit, fmt.Sprintf("修订版本 %d", revision.Version))
+
+	updated, err := s.postRepo.FindByIDWithReviewer(postID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 修改后重新进行重复检测
+	_, _ = s.similarity.Check(updated)
+
+	return updated, nil
+}
+
+// ListSimilar 获取帖子的相似匹配
+func (s *PostService) ListSimilar(postID uint) ([]*models.PostSimilarity, error) {
+	return s.similarity.ListMatches(postID)
+}
+
+// Withdraw 撤回申请(仅申请者本人，一级或二级审核中可以撤回，会释放审核锁定)
+func (s *PostService) Withdraw(postID, userID uint, reason string) error {
+	post, err := s.postRepo.FindByID(postID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("帖子不存在")
+		}
+		return err
+	}
+
+	if post.UserID != userID {
+		return errors.New("只能撤回自己的申请")
+	}
+
+	if !post.CanWithdraw() {
+		return errors.New("当前状态的申请不能撤回")
+	}
+
+	// 撤回时同时关闭二级审核中的审核员意见和锁定记录，避免之后被当作超时释放
+	err = s.postRepo.Transaction(func(tx *gorm.DB) error {
+		if err := s.postRepo.WithTx(tx).Withdraw(postID); err != nil {
+			return err
+		}
+		if err := s.decisionRepo.WithTx(tx).CloseByPost(postID); err != nil {
+			return err
+		}
+		return s.lockRepo.WithTx(tx).CloseByPost(postID, models.LockReleaseWithdraw, nil)
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("申请状态已变化，请刷新后重试")
+		}
+		return errors.New("撤回申请失败")
+	}
+
+	_ = s.eventRepo.Record(postID, userID, models.PostEventWithdraw, reason)
+
+	return nil
+}
+
+// ListEvents 获取帖子的历史事件
+func (s *PostService) ListEvents(postID uint) ([]*models.PostEvent, error) {
+	if _, err := s.postRepo.FindByID(postID); err != nil {
+		return nil, errors.New("帖子不存在")
+	}
+	return s.eventRepo.ListByPostID(postID)
+}
+
+// ListRevisions 获取帖子的修订记录
+func (s *PostService) ListRevisions(postID uint) ([]*models.PostRevision, error) {
+	if _, err := s.postRepo.FindByID(postID); err != nil {
+		return nil, errors.New("帖子不存在")
+	}
+	return s.revisionRepo.ListByPostID(postID)
+}
+
+// GetRevisionWithPrevious 获取指定版本及其上一版本(用于对比差异，原始版本的上一版本为nil)
+func (s *PostService) GetRevisionWithPrevious(postID uint, version int) (*models.PostRevision, *models.PostRevision, error) {
+	revision, err := s.revisionRepo.FindByPostAndVersion(postID, version)
+	if err != nil {
+		return nil, nil, errors.New("修订版本不存在")
+	}
+
+	if version <= 1 {
+		return revision, nil, nil
+	}
+
+	previous, err := s.revisionRepo.FindByPostAndVersion(postID, version-1)
+	if err != nil {
+		return nil, nil, errors.New("上一修订版本不存在")
+	}
+	return revision, previous, nil
+}
+
 // GetByID 根据ID获取帖子
 func (s *PostService) GetByID(id uint) (*models.Post, error) {
 	return s.postRepo.FindByIDWithReviewer(id)
@@ -102,7 +450,13 @@ func (s *PostService) ListByUserID(userID uint, page, pageSize int) ([]*models.P
 }
 
 // Vote 投票
-func (s *PostService) Vote(postID, userID uint, voteType models.VoteType) error {
+func (s *PostService) Vote(postID, userID uint, req *dto.VoteRequest) error {
+	voteType := models.VoteType(req.VoteType)
+	category, reason, err := s.validateDownvoteReason(voteType, req.ReasonCategory, req.Reason)
+	if err != nil {
+		return err
+	}
+
 	// 检查用户
 	user, err := s.userRepo.FindByID(userID)
 	if err != nil {
@@ -112,6 +466,9 @@ func (s *PostService) Vote(postID, userID uint, voteType models.VoteType) error
 	if !user.IsAdmin() && user.LinuxDoID == "" {
 		return errors.New("请先绑定 Linux.do 账号后再投票")
 	}
+	if !user.CanVote() {
+		return errors.New("您的 Linux.do 账号已被禁言或未激活，暂时无法投票")
+	}
 
 	// 检查帖子是否存在且处于一级审核状态
 	post, err := s.postRepo.FindByID(postID)
@@ -137,6 +494,9 @@ func (s *PostService) Vote(postID, userID uint, voteType models.VoteType) error
 		return err
 	}
 
+	// 不满足资格要求的投票照常记录，但不计入票数
+	ineligibleCode, ineligibleNote := s.checkVoterEligibility(user, postID)
+
 	if existingVote != nil {
 		// 已投票,更新投票
 		if existingVote.VoteType == voteType {
@@ -145,8 +505,14 @@ func (s *PostService) Vote(postID, userID uint, voteType models.VoteType) error
 				return errors.New("取消投票失败")
 			}
 		} else {
-			// 修改投票
+			// 修改投票(重新投票后不再视为修改前的投票)
 			existingVote.VoteType = voteType
+			existingVote.Stale = false
+			existingVote.ReasonCategory = category
+			existingVote.Reason = reason
+			existingVote.Ineligible = ineligibleCode != ""
+			existingVote.IneligibleCode = ineligibleCode
+			existingVote.IneligibleNote = ineligibleNote
 			if err := s.voteRepo.Update(existingVote); err != nil {
 				return errors.New("修改投票失败")
 			}
@@ -154,9 +520,14 @@ func (s *PostService) Vote(postID, userID uint, voteType models.VoteType) error
 	} else {
 		// 未投票,创建投票
 		vote := &models.Vote{
-			PostID:   postID,
-			UserID:   userID,
-			VoteType: voteType,
+			PostID:         postID,
+			UserID:         userID,
+			VoteType:       voteType,
+			ReasonCategory: category,
+			Reason:         reason,
+			Ineligible:     ineligibleCode != "",
+			IneligibleCode: ineligibleCode,
+			IneligibleNote: ineligibleNote,
 		}
 		if err := s.voteRepo.Create(vote); err != nil {
 			return errors.New("投票失败")
@@ -177,6 +548,39 @@ func (s *PostService) Vote(postID, userID uint, voteType models.VoteType) error
 	return s.checkAndUpdatePostStatus(postID, int(upVotes), int(downVotes))
 }
 
+// checkVoterEligibility 检查投票者是否满足计票资格，返回不满足的原因分类和说明(满足时均为空)
+// 所在投票圈已被作废的成员在相关申请上始终不计票，其余限制管理员不受影响
+func (s *PostService) checkVoterEligibility(user *models.User, postID uint) (string, string) {
+	if discount, err := s.clusterRepo.FindDiscount(user.ID, postID); err == nil {
+		return models.IneligibleVoteRing, voteRingNote(discount.ClusterID)
+	}
+
+	if user.IsAdmin() {
+		return "", ""
+	}
+
+	if minLevel := s.configRepo.GetInt(models.ConfigVoteMinTrustLevel, models.DefaultVoteMinTrustLevel); user.TrustLevel < minLevel {
+		return models.IneligibleTrustLevel, fmt.Sprintf("Linux.do 信任等级需达到 %d 级", minLevel)
+	}
+
+	if minDays := s.configRepo.GetInt(models.ConfigVoteMinAccountDays, models.DefaultVoteMinAccountDays); minDays > 0 &&
+		time.Since(user.CreatedAt) < time.Duration(minDays)*24*time.Hour {
+		return models.IneligibleAccountAge, fmt.Sprintf("账号注册需满 %d 天", minDays)
+	}
+
+	if minVotes := s.configRepo.GetInt(models.ConfigVoteMinPriorVotes, models.DefaultVoteMinPriorVotes); minVotes > 0 {
+		count, err := s.voteRepo.CountByUserExcludingPost(user.ID, postID)
+		if err == nil && count < int64(minVotes) {
+			return models.IneligiblePriorVotes, fmt.Sprintf("需先在其他申请上投满 %d 票(当前 %d 票)", minVotes, count)
+		}
+	}
+
+	if note := s.reputation.CheckMinimum(user.ID); note != "" {
+		return models.IneligibleReputation, note
+	}
+	return "", ""
+}
+
 // checkAndUpdatePostStatus 检查并更新帖子状态
 // 根据投票结果判断是否应该进入二级审核或被拒绝
 func (s *PostService) checkAndUpdatePostStatus(postID uint, upVotes, downVotes int) error {
@@ -188,19 +592,170 @@ func (s *PostService) checkAndUpdatePostStatus(postID uint, upVotes, downVotes i
 		return nil // 票数不足,不更新状态
 	}
 
-	// 计算赞率
+	// 计算赞率(开启信誉加权时按投票者信誉加权)
 	currentRate := float64(0)
 	if totalVotes > 0 {
 		currentRate = float64(upVotes) / float64(totalVotes) * 100
 	}
+	if s.reputation.WeightingEnabled() {
+		if rate, err := s.reputation.WeightedApprovalRate(postID); err == nil {
+			currentRate = rate
+		}
+	}
 
 	if currentRate >= float64(approvalRate) {
 		// 赞率达标,进入二级审核
-		return s.postRepo.PromoteToSecondReview(postID)
+		if err := s.postRepo.PromoteToSecondReview(postID); err != nil {
+			return err
+		}
+		_ = s.eventRepo.Record(postID, 0, models.PostEventPromote, "")
+		return nil
 	} else {
-		// 赞率不达标,拒绝
-		return s.postRepo.Reject(postID, "社区投票未通过(赞率未达到阈值)")
+		// 赞率不达标,拒绝(附上反对票中最主要的原因)
+		reason := s.buildVoteRejectReason(postID)
+		if err := s.postRepo.Reject(postID, reason); err != nil {
+			return err
+		}
+		_ = s.eventRepo.Record(postID, 0, models.PostEventReject, reason)
+		_ = s.reputation.Settle(postID, models.StatusRejected)
+		return nil
+	}
+}
+
+// renderContent 按当前配置将 Markdown 内容渲染为安全 HTML
+func (s *PostService) renderContent(content string) string {
+	opts := markdown.Options{
+		NofollowLinks: s.configRepo.GetBool(models.ConfigMarkdownNofollowLinks, false),
+	}
+	if s.configRepo.GetBool(models.ConfigMarkdownImageProxyEnabled, false) {
+		opts.ImageProxyURL = s.configRepo.GetString(models.ConfigMarkdownImageProxyURL, "")
+	}
+	return markdown.Render(content, opts)
+}
+
+// RenderAllContent 重新渲染帖子内容(onlyMissing 为 true 时只渲染尚未渲染的帖子)，返回渲染数量
+func (s *PostService) RenderAllContent(onlyMissing bool) (int, error) {
+	const batchSize = 200
+
+	count := 0
+	var lastID uint
+	for {
+		posts, err := s.postRepo.ListContentBatch(lastID, batchSize, onlyMissing)
+		if err != nil {
+			return count, err
+		}
+		for _, post := range posts {
+			if err := s.postRepo.UpdateContentHTML(post.ID, s.renderContent(post.Content)); err != nil {
+				return count, err
+			}
+			count++
+			lastID = post.ID
+		}
+		if len(posts) < batchSize {
+			return count, nil
+		}
+	}
+}
+
+// validateDownvoteReason 校验反对原因，返回规范化后的分类和说明
+// 赞成票不记录原因
+func (s *PostService) validateDownvoteReason(voteType models.VoteType, category, reason string) (string, string, error) {
+	if voteType != models.VoteDown {
+		return "", "", nil
 	}
+
+	category = strings.TrimSpace(category)
+	reason = strings.TrimSpace(reason)
+
+	if category != "" {
+		categories := s.configRepo.GetList(models.ConfigDownvoteReasonCategories, models.DefaultDownvoteReasonCategories)
+		valid := false
+		for _, c := range categories {
+			if c == category {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return "", "", errors.New("无效的反对原因分类")
+		}
+	}
+
+	if category == "" && reason == "" && s.configRepo.GetBool(models.ConfigDownvoteReasonRequired, false) {
+		return "", "", errors.New("投反对票时请选择原因分类或填写原因")
+	}
+
+	return category, reason, nil
+}
+
+// buildVoteRejectReason 生成投票未通过的拒绝原因
+func (s *PostService) buildVoteRejectReason(postID uint) string {
+	reason := "社区投票未通过(赞率未达到阈值)"
+
+	counts, err := s.voteRepo.CountDownvoteReasons(postID)
+	if err != nil || len(counts) == 0 {
+		return reason
+	}
+
+	// 最多列出前三个原因
+	if len(counts) > 3 {
+		counts = counts[:3]
+	}
+	parts := make([]string, len(counts))
+	for i, c := range counts {
+		parts[i] = fmt.Sprintf("%s(%d)", c.Category, c.Count)
+	}
+	return reason + "，主要反对原因：" + strings.Join(parts, "、")
+}
+
+// GetVoteOptions 获取投票选项(反对原因分类及是否必填)
+func (s *PostService) GetVoteOptions() *dto.VoteOptionsResponse {
+	return &dto.VoteOptionsResponse{
+		DownvoteReasonRequired: s.configRepo.GetBool(models.ConfigDownvoteReasonRequired, false),
+		DownvoteCategories:     s.configRepo.GetList(models.ConfigDownvoteReasonCategories, models.DefaultDownvoteReasonCategories),
+	}
+}
+
+// GetDownvoteReasons 获取帖子的反对原因汇总
+// 申请者本人在审核结束后可查看，认证用户和管理员随时可查看(盲投模式下投票阶段仅管理员可查看)
+func (s *PostService) GetDownvoteReasons(postID, viewerID uint, canManage, isAdmin bool) (*dto.DownvoteReasonsResponse, error) {
+	post, err := s.postRepo.FindByID(postID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("帖子不存在")
+		}
+		return nil, err
+	}
+
+	if !canManage {
+		if post.UserID != viewerID {
+			return nil, errors.New("无权查看反对原因")
+		}
+		if post.Status != models.StatusApproved && post.Status != models.StatusRejected {
+			return nil, errors.New("审核结束后才能查看反对原因")
+		}
+	}
+
+	// 盲投模式下投票阶段的反对原因会泄露票数，只有管理员可以查看
+	if s.IsTallyHidden(post.Status, isAdmin) {
+		return nil, errors.New("盲投模式下投票结束后才能查看反对原因")
+	}
+
+	counts, err := s.voteRepo.CountDownvoteReasons(postID)
+	if err != nil {
+		return nil, err
+	}
+	texts, err := s.voteRepo.ListDownvoteReasonTexts(postID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.DownvoteReasonsResponse{
+		PostID:     postID,
+		DownVotes:  post.DownVotes,
+		Categories: counts,
+		Reasons:    texts,
+	}, nil
 }
 
 // getReviewConfig 获取审核配置
@@ -238,16 +793,16 @@ func (s *PostService) getReviewConfig() (minVotes int, approvalRate int) {
 	return minVotes, approvalRate
 }
 
-// GetUserVoteForPost 获取用户对帖子的投票
-func (s *PostService) GetUserVoteForPost(postID, userID uint) (int, error) {
+// GetUserVote 获取用户对帖子的投票记录(未投票时返回nil)
+func (s *PostService) GetUserVote(postID, userID uint) (*models.Vote, error) {
 	vote, err := s.voteRepo.FindByPostAndUser(postID, userID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return 0, nil
+			return nil, nil
 		}
-		return 0, err
+		return nil, err
 	}
-	return int(vote.VoteType), nil
+	return vote, nil
 }
 
 // GetUserVotesForPosts 获取用户对多个帖子的投票
@@ -265,8 +820,8 @@ func (s *PostService) GetUserVotesForPosts(userID uint, postIDs []uint) (map[uin
 }
 
 // VoteWithResponse 投票并返回结果
-func (s *PostService) VoteWithResponse(postID, userID uint, voteType models.VoteType) (*dto.VoteResponse, error) {
-	if err := s.Vote(postID, userID, voteType); err != nil {
+func (s *PostService) VoteWithResponse(postID, userID uint, req *dto.VoteRequest) (*dto.VoteResponse, error) {
+	if err := s.Vote(postID, userID, req); err != nil {
 		return nil, err
 	}
 
@@ -287,15 +842,35 @@ func (s *PostService) VoteWithResponse(postID, userID uint, voteType models.Vote
 
 	if currentVote != nil {
 		resp.VoteType = currentVote.VoteType
+		resp.Counted = !currentVote.Ineligible
+		resp.IneligibleReason = currentVote.IneligibleNote
 		resp.Message = "投票成功"
+		if currentVote.Ineligible {
+			resp.Message = "投票已记录，但暂不计入票数: " + currentVote.IneligibleNote
+		}
 	} else {
 		resp.VoteType = 0
 		resp.Message = "已取消投票"
 	}
 
+	if user, err := s.userRepo.FindByID(userID); err == nil && s.IsTallyHidden(post.Status, user.IsAdmin()) {
+		resp.UpVotes = 0
+		resp.DownVotes = 0
+		resp.TallyHidden = true
+	}
+
 	return resp, nil
 }
 
+// IsTallyHidden 盲投模式下申请处于投票阶段时，非管理员看不到票数和赞率
+// 申请离开投票阶段(进入二级审核或审核结束)后票数公开
+func (s *PostService) IsTallyHidden(status models.PostStatus, isAdmin bool) bool {
+	if isAdmin || status != models.StatusFirstReview {
+		return false
+	}
+	return s.configRepo.GetBool(models.ConfigBlindVoting, false)
+}
+
 // GetReviewConfig 获取审核配置(公开方法)
 func (s *PostService) GetReviewConfig() (minVotes int, approvalRate int) {
 	return s.getReviewConfig()
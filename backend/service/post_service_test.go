@@ -0,0 +1,159 @@
+package service
+
+import (
+	"testing"
+
+	"linuxdo-review/dto"
+	"linuxdo-review/models"
+)
+
+func TestVoteEligibility(t *testing.T) {
+	tests := []struct {
+		name       string
+		config     map[string]interface{}
+		priorTrust int // 在其他申请上投票时的信任等级
+		trust      int // 最后一次投票时的信任等级
+		role       models.UserRole
+		priorVotes int // 最后一次投票前在其他申请上的投票数
+		wantCode   string
+	}{
+		{
+			name:     "无限制",
+			trust:    0,
+			wantCode: "",
+		},
+		{
+			name:     "信任等级不足",
+			config:   map[string]interface{}{models.ConfigVoteMinTrustLevel: 2},
+			trust:    1,
+			wantCode: models.IneligibleTrustLevel,
+		},
+		{
+			name:     "管理员不受限制",
+			config:   map[string]interface{}{models.ConfigVoteMinTrustLevel: 2, models.ConfigVoteMinPriorVotes: 3},
+			trust:    0,
+			role:     models.RoleAdmin,
+			wantCode: "",
+		},
+		{
+			name:       "投票数不足",
+			config:     map[string]interface{}{models.ConfigVoteMinPriorVotes: 3},
+			priorVotes: 2,
+			wantCode:   models.IneligiblePriorVotes,
+		},
+		{
+			name:       "新投票者投满票数后计票",
+			config:     map[string]interface{}{models.ConfigVoteMinPriorVotes: 3},
+			priorVotes: 3,
+			wantCode:   "",
+		},
+		{
+			name:       "信任等级不足时的投票不计入投票数",
+			config:     map[string]interface{}{models.ConfigVoteMinTrustLevel: 2, models.ConfigVoteMinPriorVotes: 3},
+			priorTrust: 1,
+			trust:      2,
+			priorVotes: 3,
+			wantCode:   models.IneligiblePriorVotes,
+		},
+		{
+			name:     "信誉分不足",
+			config:   map[string]interface{}{models.ConfigVoteMinReputation: 10},
+			wantCode: models.IneligibleReputation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newTestDB(t)
+			s := newTestPostService(db)
+			for key, value := range tt.config {
+				setConfig(t, db, key, value)
+			}
+			author := createUser(t, db, "author", 3, models.RoleCertified)
+			voter := createUser(t, db, "voter", tt.priorTrust, tt.role)
+			up := &dto.VoteRequest{VoteType: int(models.VoteUp)}
+
+			for i := 0; i < tt.priorVotes; i++ {
+				post := createVotingPost(t, db, author.ID)
+				if err := s.Vote(post.ID, voter.ID, up); err != nil {
+					t.Fatalf("prior vote %d: %v", i, err)
+				}
+			}
+
+			voter.TrustLevel = tt.trust
+			if err := db.Save(voter).Error; err != nil {
+				t.Fatalf("update voter: %v", err)
+			}
+			post := createVotingPost(t, db, author.ID)
+			if err := s.Vote(post.ID, voter.ID, up); err != nil {
+				t.Fatalf("vote: %v", err)
+			}
+
+			vote := findVote(t, db, post.ID, voter.ID)
+			if vote.IneligibleCode != tt.wantCode || vote.Ineligible != (tt.wantCode != "") {
+				t.Errorf("vote ineligible = %v (%q), want code %q", vote.Ineligible, vote.IneligibleCode, tt.wantCode)
+			}
+
+			wantUp := 1
+			if tt.wantCode != "" {
+				wantUp = 0
+			}
+			reloaded, _ := s.postRepo.FindByID(post.ID)
+			if reloaded.UpVotes != wantUp {
+				t.Errorf("post up votes = %d, want %d", reloaded.UpVotes, wantUp)
+			}
+		})
+	}
+}
+
+func TestVoteChangeKeepsEligibility(t *testing.T) {
+	db := newTestDB(t)
+	s := newTestPostService(db)
+	setConfig(t, db, models.ConfigVoteMinTrustLevel, 2)
+	author := createUser(t, db, "author", 3, models.RoleCertified)
+	voter := createUser(t, db, "voter", 1, models.RoleNormal)
+	post := createVotingPost(t, db, author.ID)
+
+	for i, voteType := range []models.VoteType{models.VoteUp, models.VoteDown} {
+		if err := s.Vote(post.ID, voter.ID, &dto.VoteRequest{VoteType: int(voteType)}); err != nil {
+			t.Fatalf("vote %d: %v", i, err)
+		}
+		vote := findVote(t, db, post.ID, voter.ID)
+		if !vote.Ineligible || vote.IneligibleCode != models.IneligibleTrustLevel {
+			t.Errorf("vote %d: ineligible = %v (%q), want trust level", i, vote.Ineligible, vote.IneligibleCode)
+		}
+	}
+}
+
+func TestCreateHold(t *testing.T) {
+	tests := []struct {
+		name       string
+		moderation bool
+		wantStatus models.PostStatus
+	}{
+		{"未开启预审直接进入投票", false, models.StatusFirstReview},
+		{"开启预审进入待审核", true, models.StatusPending},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newTestDB(t)
+			s := newTestPostService(db)
+			setConfig(t, db, models.ConfigModerationEnabled, tt.moderation)
+			applicant := createUser(t, db, "applicant", 1, models.RoleNormal)
+
+			post, err := s.Create(applicant.ID, &dto.CreatePostRequest{
+				Title:   "申请邀请码测试标题",
+				Content: "这是一段用于测试的申请内容，长度需要达到自由填写内容的最低字数要求，所以这里多写一些文字来凑够字数。",
+			})
+			if err != nil {
+				t.Fatalf("create: %v", err)
+			}
+
+			stored, _ := s.postRepo.FindByID(post.ID)
+			if post.Status != tt.wantStatus || stored.Status != tt.wantStatus {
+				t.Errorf("status = %d (stored %d), want %d", post.Status, stored.Status, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"linuxdo-review/dto"
+	"linuxdo-review/models"
+	"linuxdo-review/repository"
+
+	"gorm.io/gorm"
+)
+
+// 因举报被自动隐藏的申请的暂停原因前缀
+const reportHoldReasonPrefix = "被多名用户举报"
+
+// ReportService 举报服务
+type ReportService struct {
+	reportRepo  *repository.ReportRepository
+	postRepo    *repository.PostRepository
+	commentRepo *repository.CommentRepository
+	userRepo    *repository.UserRepository
+	configRepo  *repository.ConfigRepository
+	eventRepo   *repository.PostEventRepository
+}
+
+// NewReportService 创建举报服务
+func NewReportService(
+	reportRepo *repository.ReportRepository,
+	postRepo *repository.PostRepository,
+	commentRepo *repository.CommentRepository,
+	userRepo *repository.UserRepository,
+	configRepo *repository.ConfigRepository,
+	eventRepo *repository.PostEventRepository,
+) *ReportService {
+	return &ReportService{
+		reportRepo:  reportRepo,
+		postRepo:    postRepo,
+		commentRepo: commentRepo,
+		userRepo:    userRepo,
+		configRepo:  configRepo,
+		eventRepo:   eventRepo,
+	}
+}
+
+// GetCategories 获取举报分类
+func (s *ReportService) GetCategories() []string {
+	return s.configRepo.GetList(models.ConfigReportCategories, models.DefaultReportCategories)
+}
+
+// Create 提交举报(同一用户对同一对象只能举报一次)
+func (s *ReportService) Create(reporterID uint, req *dto.CreateReportRequest) (*models.Report, error) {
+	valid := false
+	for _, c := range s.GetCategories() {
+		if c == req.Category {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return nil, errors.New("无效的举报分类")
+	}
+
+	report := &models.Report{
+		ReporterID: reporterID,
+		TargetType: req.TargetType,
+		TargetID:   req.TargetID,
+		Category:   req.Category,
+		Note:       strings.TrimSpace(req.Note),
+		Status:     models.ReportOpen,
+	}
+
+	// 校验举报对象并记录所属申请
+	var ownerID uint
+	switch req.TargetType {
+	case models.ReportTargetPost:
+		post, err := s.postRepo.FindByID(req.TargetID)
+		if err != nil {
+			return nil, errors.New("帖子不存在")
+		}
+		ownerID = post.UserID
+		report.PostID = post.ID
+	case models.ReportTargetComment:
+		comment, err := s.commentRepo.FindByID(req.TargetID)
+		if err != nil || comment.Deleted {
+			return nil, errors.New("评论不存在")
+		}
+		ownerID = comment.UserID
+		report.PostID = comment.PostID
+	case models.ReportTargetUser:
+		user, err := s.userRepo.FindByID(req.TargetID)
+		if err != nil {
+			return nil, errors.New("用户不存在")
+		}
+		ownerID = user.ID
+	default:
+		return nil, errors.New("无效的举报对象")
+	}
+	if ownerID == reporterID {
+		return nil, errors.New("不能举报自己")
+	}
+
+	exists, err := s.reportRepo.Exists(reporterID, req.TargetType, req.TargetID)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, errors.New("您已举报过该内容，请等待管理员处理")
+	}
+
+	if err := s.reportRepo.Create(report); err != nil {
+		return nil, errors.New("提交举报失败")
+	}
+
+	if req.TargetType == models.ReportTargetPost {
+		s.autoHidePost(req.TargetID)
+	}
+
+	return report, nil
+}
+
+// autoHidePost 投票中的申请被足够多的可信用户举报后自动隐藏，等待管理员处理
+func (s *ReportService) autoHidePost(postID uint) {
+	threshold := s.configRepo.GetInt(models.ConfigReportAutoHideThreshold, models.DefaultReportAutoHideThreshold)
+	if threshold <= 0 {
+		return
+	}
+
+	minLevel := s.configRepo.GetInt(models.ConfigReportTrustedLevel, models.DefaultReportTrustedLevel)
+	count, err := s.reportRepo.CountTrustedOpenByTarget(models.ReportTargetPost, postID, minLevel)
+	if err != nil || count < int64(threshold) {
+		return
+	}
+
+	reason := fmt.Sprintf("%s(%d 人)，自动隐藏等待管理员处理", reportHoldReasonPrefix, count)
+	if err := s.postRepo.Hold(postID, reason); err != nil {
+		// 不在投票中或已被隐藏
+		return
+	}
+	_ = s.eventRepo.Record(postID, 0, models.PostEventHold, reason)
+}
+
+// List 获取举报队列
+func (s *ReportService) List(status *int, targetType string, page, pageSize int) ([]*models.Report, int64, error) {
+	offset := (page - 1) * pageSize
+	return s.reportRepo.List(status, targetType, offset, pageSize)
+}
+
+// Resolve 确认举报属实，同时处理该对象的其他待处理举报
+func (s *ReportService) Resolve(id, handlerID uint, note string) (int64, error) {
+	report, err := s.findOpen(id)
+	if err != nil {
+		return 0, err
+	}
+	return s.reportRepo.CloseByTarget(report.TargetType, report.TargetID, models.ReportResolved, handlerID, note)
+}
+
+// Dismiss 驳回举报，同时驳回该对象的其他待处理举报
+// 因举报被自动隐藏的申请会重新放行到社区投票
+func (s *ReportService) Dismiss(id, handlerID uint, note string) (int64, error) {
+	report, err := s.findOpen(id)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := s.reportRepo.CloseByTarget(report.TargetType, report.TargetID, models.ReportDismissed, handlerID, note)
+	if err != nil {
+		return 0, err
+	}
+
+	if report.TargetType == models.ReportTargetPost {
+		post, err := s.postRepo.FindByID(report.TargetID)
+		if err == nil && post.Status == models.StatusPending && strings.HasPrefix(post.HoldReason, reportHoldReasonPrefix) {
+			if err := s.postRepo.Release(post.ID); err == nil {
+				_ = s.eventRepo.Record(post.ID, handlerID, models.PostEventRelease, "举报被驳回，恢复社区投票")
+			}
+		}
+	}
+
+	return count, nil
+}
+
+func (s *ReportService) findOpen(id uint) (*models.Report, error) {
+	report, err := s.reportRepo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("举报不存在")
+		}
+		return nil, err
+	}
+	if !report.IsOpen() {
+		return nil, errors.New("该举报已处理")
+	}
+	return report, nil
+}
@@ -0,0 +1,269 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"linuxdo-review/dto"
+	"linuxdo-review/models"
+	"linuxdo-review/repository"
+
+	"gorm.io/gorm"
+)
+
+const (
+	minLeaderboardSettled = 5   // 进入排行榜至少需要结算的投票数
+	minReputationWeight   = 0.5 // 信誉加权时单票的最小权重
+	maxReputationWeight   = 2.0 // 信誉加权时单票的最大权重
+)
+
+// ReputationService 投票者信誉服务
+type ReputationService struct {
+	reputationRepo *repository.ReputationRepository
+	voteRepo       *repository.VoteRepository
+	configRepo     *repository.ConfigRepository
+}
+
+// NewReputationService 创建投票者信誉服务
+func NewReputationService(
+	reputationRepo *repository.ReputationRepository,
+	voteRepo *repository.VoteRepository,
+	configRepo *repository.ConfigRepository,
+) *ReputationService {
+	return &ReputationService{
+		reputationRepo: reputationRepo,
+		voteRepo:       voteRepo,
+		configRepo:     configRepo,
+	}
+}
+
+// Settle 申请通过或被拒绝后结算投票者信誉: 与最终结果一致加1分，不一致扣1分
+// 每张投票只结算一次(申诉重新开启后再次结束不会重复计算)
+// 整个结算在同一事务中完成，中途失败后重新结算不会重复计分
+func (s *ReputationService) Settle(postID uint, status models.PostStatus) error {
+	if status != models.StatusApproved && status != models.StatusRejected {
+		return nil
+	}
+
+	return s.reputationRepo.Transaction(func(tx *gorm.DB) error {
+		reputationRepo := s.reputationRepo.WithTx(tx)
+		voteRepo := s.voteRepo.WithTx(tx)
+
+		votes, err := voteRepo.ListUnsettledByPost(postID)
+		if err != nil || len(votes) == 0 {
+			return err
+		}
+
+		now := time.Now()
+		ids := make([]uint, len(votes))
+		for i, vote := range votes {
+			ids[i] = vote.ID
+
+			rep, err := reputationRepo.FindByUserID(vote.UserID)
+			if err != nil {
+				return err
+			}
+			if rep == nil {
+				rep = &models.VoterReputation{UserID: vote.UserID, DecayedAt: now}
+			}
+			rep.Score = s.decay(rep, now)
+			rep.DecayedAt = now
+
+			aligned := (status == models.StatusApproved && vote.IsUpVote()) || (status == models.StatusRejected && vote.IsDownVote())
+			if aligned {
+				rep.Score++
+				rep.Aligned++
+			} else {
+				rep.Score--
+				rep.Misaligned++
+			}
+			if err := reputationRepo.Save(rep); err != nil {
+				return err
+			}
+		}
+
+		return voteRepo.MarkSettled(ids)
+	})
+}
+
+// GetScore 获取用户当前的信誉分(没有记录时为0)
+func (s *ReputationService) GetScore(userID uint) float64 {
+	rep, err := s.reputationRepo.FindByUserID(userID)
+	if err != nil || rep == nil {
+		return 0
+	}
+	return s.decay(rep, time.Now())
+}
+
+// Get 获取用户的信誉
+func (s *ReputationService) Get(userID uint) (*dto.ReputationResponse, error) {
+	rep, err := s.reputationRepo.FindByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if rep == nil {
+		rep = &models.VoterReputation{UserID: userID}
+	}
+	return s.toResponse(rep, time.Now()), nil
+}
+
+// Leaderboard 获取信誉排行榜
+func (s *ReputationService) Leaderboard(limit int) ([]*dto.ReputationResponse, error) {
+	reps, err := s.reputationRepo.ListWithMinSettled(minLeaderboardSettled)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	list := make([]*dto.ReputationResponse, len(reps))
+	for i, rep := range reps {
+		list[i] = s.toResponse(rep, now)
+		if rep.User != nil {
+			list[i].User = dto.ToPublicUserResponse(rep.User)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Score != list[j].Score {
+			return list[i].Score > list[j].Score
+		}
+		return list[i].UserID < list[j].UserID
+	})
+	if len(list) > limit {
+		list = list[:limit]
+	}
+	return list, nil
+}
+
+// CheckMinimum 检查用户信誉是否达到计票要求，返回不满足的原因(未配置或满足时返回空)
+func (s *ReputationService) CheckMinimum(userID uint) string {
+	value := strings.TrimSpace(s.configRepo.GetString(models.ConfigVoteMinReputation, ""))
+	if value == "" {
+		return ""
+	}
+	minScore, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return ""
+	}
+	if score := s.GetScore(userID); score < minScore {
+		return fmt.Sprintf("投票者信誉分需达到 %g(当前 %.1f)", minScore, score)
+	}
+	return ""
+}
+
+// WeightingEnabled 是否按信誉加权计算赞率
+func (s *ReputationService) WeightingEnabled() bool {
+	return s.configRepo.GetBool(models.ConfigReputationVoteWeight, false)
+}
+
+// WeightedApprovalRate 按投票者信誉加权计算帖子的赞率(百分比)
+func (s *ReputationService) WeightedApprovalRate(postID uint) (float64, error) {
+	up, total, err := s.WeightedVotes(postID)
+	if err != nil || total == 0 {
+		return 0, err
+	}
+	return up / total * 100, nil
+}
+
+// WeightedVotes 按投票者信誉加权统计帖子的赞成票和总票数
+func (s *ReputationService) WeightedVotes(postID uint) (up, total float64, err error) {
+	votes, err := s.voteRepo.ListCountedByPost(postID)
+	if err != nil || len(votes) == 0 {
+		return 0, 0, err
+	}
+
+	weights, err := s.voterWeights(votes)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, vote := range votes {
+		w := weights[vote.UserID]
+		total += w
+		if vote.IsUpVote() {
+			up += w
+		}
+	}
+	return up, total, nil
+}
+
+// WeightedVoteTotals 按投票者信誉加权批量统计多个帖子的总票数(一次查询投票和信誉)
+func (s *ReputationService) WeightedVoteTotals(postIDs []uint) (map[uint]float64, error) {
+	totals := make(map[uint]float64, len(postIDs))
+	votes, err := s.voteRepo.ListCountedByPosts(postIDs)
+	if err != nil || len(votes) == 0 {
+		return totals, err
+	}
+
+	weights, err := s.voterWeights(votes)
+	if err != nil {
+		return nil, err
+	}
+	for _, vote := range votes {
+		totals[vote.PostID] += weights[vote.UserID]
+	}
+	return totals, nil
+}
+
+// GetScores 批量获取多个用户当前的信誉分(没有记录的用户为0)
+func (s *ReputationService) GetScores(userIDs []uint) (map[uint]float64, error) {
+	reps, err := s.reputationRepo.FindByUserIDs(userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	scores := make(map[uint]float64, len(userIDs))
+	for _, rep := range reps {
+		scores[rep.UserID] = s.decay(rep, now)
+	}
+	return scores, nil
+}
+
+// voterWeights 获取投票者的信誉权重(没有信誉记录的按0分计算)
+func (s *ReputationService) voterWeights(votes []*models.Vote) (map[uint]float64, error) {
+	userIDs := make([]uint, len(votes))
+	for i, vote := range votes {
+		userIDs[i] = vote.UserID
+	}
+	scores, err := s.GetScores(userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	weights := make(map[uint]float64, len(userIDs))
+	for _, id := range userIDs {
+		weights[id] = reputationWeight(scores[id])
+	}
+	return weights, nil
+}
+
+// decay 按半衰期计算信誉分衰减到指定时间后的值
+func (s *ReputationService) decay(rep *models.VoterReputation, at time.Time) float64 {
+	halfLife := s.configRepo.GetInt(models.ConfigReputationHalfLifeDays, models.DefaultReputationHalfLifeDays)
+	if halfLife <= 0 || rep.DecayedAt.IsZero() || !at.After(rep.DecayedAt) {
+		return rep.Score
+	}
+	days := at.Sub(rep.DecayedAt).Hours() / 24
+	return rep.Score * math.Pow(0.5, days/float64(halfLife))
+}
+
+func (s *ReputationService) toResponse(rep *models.VoterReputation, at time.Time) *dto.ReputationResponse {
+	score := s.decay(rep, at)
+	return &dto.ReputationResponse{
+		UserID:     rep.UserID,
+		Score:      math.Round(score*100) / 100,
+		Aligned:    rep.Aligned,
+		Misaligned: rep.Misaligned,
+		Accuracy:   math.Round(rep.Accuracy()*10) / 10,
+		Weight:     math.Round(reputationWeight(score)*100) / 100,
+	}
+}
+
+// reputationWeight 信誉分对应的投票权重: 每10分增减1倍，限制在 [0.5, 2] 之间
+func reputationWeight(score float64) float64 {
+	return math.Max(minReputationWeight, math.Min(maxReputationWeight, 1+score/10))
+}
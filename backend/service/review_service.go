@@ -2,8 +2,14 @@ package service
 
 import (
 	"errors"
+	"fmt"
+	"log"
+	"math"
+	"sort"
 	"strconv"
+	"time"
 
+	"linuxdo-review/dto"
 	"linuxdo-review/models"
 	"linuxdo-review/repository"
 
@@ -15,7 +21,15 @@ type ReviewService struct {
 	postRepo     *repository.PostRepository
 	userRepo     *repository.UserRepository
 	configRepo   *repository.ConfigRepository
+	eventRepo    *repository.PostEventRepository
 	emailService *EmailService
+	reputation   *ReputationService
+	decisionRepo *repository.ReviewDecisionRepository
+	noteRepo     *repository.ReviewerNoteRepository
+	lockRepo     *repository.ReviewLockRepository
+	skipRepo     *repository.ReviewSkipRepository
+	conflicts    *ConflictService
+	voteRepo     *repository.VoteRepository
 }
 
 // NewReviewService 创建审核服务
@@ -23,13 +37,29 @@ func NewReviewService(
 	postRepo *repository.PostRepository,
 	userRepo *repository.UserRepository,
 	configRepo *repository.ConfigRepository,
+	eventRepo *repository.PostEventRepository,
 	emailService *EmailService,
+	reputation *ReputationService,
+	decisionRepo *repository.ReviewDecisionRepository,
+	noteRepo *repository.ReviewerNoteRepository,
+	lockRepo *repository.ReviewLockRepository,
+	skipRepo *repository.ReviewSkipRepository,
+	conflicts *ConflictService,
+	voteRepo *repository.VoteRepository,
 ) *ReviewService {
 	return &ReviewService{
 		postRepo:     postRepo,
 		userRepo:     userRepo,
 		configRepo:   configRepo,
+		eventRepo:    eventRepo,
 		emailService: emailService,
+		reputation:   reputation,
+		decisionRepo: decisionRepo,
+		noteRepo:     noteRepo,
+		lockRepo:     lockRepo,
+		skipRepo:     skipRepo,
+		conflicts:    conflicts,
+		voteRepo:     voteRepo,
 	}
 }
 
@@ -58,7 +88,7 @@ func (s *ReviewService) CheckAndPromote(postID uint) error {
 
 	if rate >= float64(approvalRate) {
 		// 进入二级审核
-		return s.postRepo.UpdateStatus(postID, models.StatusSecondReview)
+		return s.postRepo.PromoteToSecondReview(postID)
 	} else {
 		// 拒绝
 		return s.postRepo.UpdateStatus(postID, models.StatusRejected)
@@ -111,6 +141,9 @@ func (s *ReviewService) ApproveWithNotification(postID uint, reviewerID uint, in
 	if err := s.postRepo.Approve(postID, reviewerID, inviteCode); err != nil {
 		return err
 	}
+	_ = s.eventRepo.Record(postID, reviewerID, models.PostEventApprove, "")
+	_ = s.reputation.Settle(postID, models.StatusApproved)
+	_ = s.decisionRepo.CloseByPost(postID)
 
 	// 发送邮件通知申请者
 	if s.emailService != nil && post.User != nil && post.User.Email != "" {
@@ -147,7 +180,7 @@ func (s *ReviewService) Reject(postID uint, reason string) error {
 }
 
 // RejectWithNotification 拒绝申请并发送邮件通知(完整流程)
-func (s *ReviewService) RejectWithNotification(postID uint, reason string) error {
+func (s *ReviewService) RejectWithNotification(postID uint, operatorID uint, reason string) error {
 	// 先获取完整的帖子信息(包含用户)
 	post, err := s.postRepo.FindByIDWithReviewer(postID)
 	if err != nil {
@@ -157,13 +190,16 @@ func (s *ReviewService) RejectWithNotification(postID uint, reason string) error
 		return err
 	}
 
-	if post.Status != models.StatusFirstReview && post.Status != models.StatusSecondReview {
+	if !post.CanReject() {
 		return errors.New("帖子状态不允许拒绝")
 	}
 
 	if err := s.postRepo.Reject(postID, reason); err != nil {
 		return err
 	}
+	_ = s.eventRepo.Record(postID, operatorID, models.PostEventReject, reason)
+	_ = s.reputation.Settle(postID, models.StatusRejected)
+	_ = s.decisionRepo.CloseByPost(postID)
 
 	// 发送拒绝通知邮件
 	if s.emailService != nil && post.User != nil && post.User.Email != "" {
@@ -186,52 +222,738 @@ func (s *ReviewService) GetPostByID(postID uint) (*models.Post, error) {
 	return s.postRepo.FindByIDWithReviewer(postID)
 }
 
-// GetNextForReview 获取下一个待审核的帖子并锁定
-func (s *ReviewService) GetNextForReview(userID uint, skipIDs []uint) (*models.Post, error) {
-	post, err := s.postRepo.GetNextForReview(userID, skipIDs)
+// checkReviewer 检查审核员的LinuxDo账号是否受限(被禁言或未激活的账号不能审核)
+func (s *ReviewService) checkReviewer(userID uint) error {
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return errors.New("用户不存在")
+	}
+	if user.IsLinuxDoRestricted() {
+		return errors.New("您的 Linux.do 账号已被禁言或未激活，暂时无法审核")
+	}
+	return nil
+}
+
+// GetNextForReview 获取优先级最高的待审核帖子并锁定
+// 没有可分配的帖子时返回 gorm.ErrRecordNotFound
+func (s *ReviewService) GetNextForReview(userID uint, skipIDs []uint) (*models.Post, *dto.ReviewPriority, error) {
+	if err := s.checkReviewer(userID); err != nil {
+		return nil, nil, err
+	}
+
+	// 已提交过意见的申请不再分配给该审核员
+	decided, err := s.decisionRepo.ListOpenPostIDs(userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	skipIDs = append(skipIDs, decided...)
+
+	// 排除审核员跳过且未过期的申请
+	skipped, err := s.skipRepo.ListActivePostIDs(userID, time.Now())
+	if err != nil {
+		return nil, nil, err
+	}
+	skipIDs = append(skipIDs, skipped...)
+
+	lockTimeout := s.LockTimeout()
+	candidates, err := s.postRepo.ListReviewCandidates(userID, skipIDs, lockTimeout)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 存在利益冲突的申请不分配给该审核员
+	conflicts, err := s.conflicts.FindConflicts(userID, candidates)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(conflicts) > 0 {
+		filtered := candidates[:0]
+		for _, post := range candidates {
+			if _, ok := conflicts[post.ID]; !ok {
+				filtered = append(filtered, post)
+			}
+		}
+		candidates = filtered
+	}
+
+	// 超过审核时限被升级的申请优先，其余按优先级从高到低排序，同分时先提交的申请优先
+	now := time.Now()
+	weights := s.getPriorityWeights()
+	inputs, err := s.loadPriorityInputs(candidates)
+	if err != nil {
+		return nil, nil, err
+	}
+	priorities := make(map[uint]*dto.ReviewPriority, len(candidates))
+	for _, post := range candidates {
+		priorities[post.ID] = computePriority(post, weights, inputs, now)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if a, b := candidates[i].IsEscalated(), candidates[j].IsEscalated(); a != b {
+			return a
+		}
+		return priorities[candidates[i].ID].Score > priorities[candidates[j].ID].Score
+	})
+
+	for _, post := range candidates {
+		// 锁定帖子(并发领取失败时尝试下一个)
+		if err := s.postRepo.LockPost(post.ID, userID, lockTimeout); err != nil {
+			continue
+		}
+
+		// 接管他人已过期的锁定
+		if post.LockedBy != nil && *post.LockedBy != userID {
+			_ = s.lockRepo.Close(post.ID, *post.LockedBy, models.LockReleaseExpiry, nil)
+		}
+		_ = s.lockRepo.Open(post.ID, userID)
+
+		return post, priorities[post.ID], nil
+	}
+
+	return nil, nil, gorm.ErrRecordNotFound
+}
+
+// SetPriorityBoost 设置申请在审核队列中的优先级加成(管理员)
+func (s *ReviewService) SetPriorityBoost(postID uint, boost float64) error {
+	if err := s.postRepo.SetPriorityBoost(postID, boost); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("帖子不存在")
+		}
+		return err
+	}
+	return nil
+}
+
+// priorityWeights 审核队列优先级各项的权重
+type priorityWeights struct {
+	wait, approval, votes, reputation float64
+}
+
+func (s *ReviewService) getPriorityWeights() priorityWeights {
+	return priorityWeights{
+		wait:       s.configRepo.GetFloat(models.ConfigReviewPriorityWaitWeight, models.DefaultReviewPriorityWaitWeight),
+		approval:   s.configRepo.GetFloat(models.ConfigReviewPriorityApprovalWeight, models.DefaultReviewPriorityApprovalWeight),
+		votes:      s.configRepo.GetFloat(models.ConfigReviewPriorityVotesWeight, models.DefaultReviewPriorityVotesWeight),
+		reputation: s.configRepo.GetFloat(models.ConfigReviewPriorityReputationWeight, models.DefaultReviewPriorityReputationWeight),
+	}
+}
+
+// priorityInputs 计算优先级所需的批量数据
+type priorityInputs struct {
+	weightedVotes map[uint]float64 // 按信誉加权的票数(未开启信誉加权时为nil)
+	reputation    map[uint]float64 // 申请者的信誉分
+}
+
+// loadPriorityInputs 批量加载候选申请的加权票数和申请者信誉分，避免逐个查询
+func (s *ReviewService) loadPriorityInputs(posts []*models.Post) (priorityInputs, error) {
+	var inputs priorityInputs
+	if len(posts) == 0 {
+		return inputs, nil
+	}
+
+	postIDs := make([]uint, len(posts))
+	userIDs := make([]uint, len(posts))
+	for i, post := range posts {
+		postIDs[i] = post.ID
+		userIDs[i] = post.UserID
+	}
+
+	var err error
+	if s.reputation.WeightingEnabled() {
+		if inputs.weightedVotes, err = s.reputation.WeightedVoteTotals(postIDs); err != nil {
+			return inputs, err
+		}
+	}
+	inputs.reputation, err = s.reputation.GetScores(userIDs)
+	return inputs, err
+}
+
+// computePriority 计算申请的审核优先级:
+// 等待小时数、赞率、票数(开启信誉加权时按权重)、申请者信誉分分别乘以权重，再加上管理员设置的加成
+func computePriority(post *models.Post, w priorityWeights, in priorityInputs, now time.Time) *dto.ReviewPriority {
+	since := post.CreatedAt
+	if post.SecondReviewAt != nil {
+		since = *post.SecondReviewAt
+	}
+
+	votes := float64(post.TotalVotes())
+	if in.weightedVotes != nil {
+		votes = in.weightedVotes[post.ID]
+	}
+
+	waitHours := now.Sub(since).Hours()
+	approvalRate := post.ApprovalRate()
+	reputation := in.reputation[post.UserID]
+	score := waitHours*w.wait + approvalRate*w.approval + votes*w.votes + reputation*w.reputation + post.PriorityBoost
+
+	return &dto.ReviewPriority{
+		Score:               math.Round(score*100) / 100,
+		WaitHours:           math.Round(waitHours*100) / 100,
+		ApprovalRate:        math.Round(approvalRate*100) / 100,
+		Votes:               math.Round(votes*100) / 100,
+		ApplicantReputation: math.Round(reputation*100) / 100,
+		Boost:               post.PriorityBoost,
+		Escalated:           post.IsEscalated(),
+	}
+}
+
+// SkipPost 跳过当前帖子（解锁并记录跳过，之后不再分配给该审核员）
+// expireHours 为空时使用系统配置，为0表示不过期
+func (s *ReviewService) SkipPost(postID uint, userID uint, expireHours *int) error {
+	post, err := s.postRepo.FindByID(postID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("帖子不存在")
+		}
+		return err
+	}
+
+	s.releaseLock(postID, userID, models.LockReleaseSkip, nil)
+	if post.Status != models.StatusSecondReview {
+		return nil
+	}
+
+	hours := s.configRepo.GetInt(models.ConfigReviewSkipHours, models.DefaultReviewSkipHours)
+	if expireHours != nil && *expireHours >= 0 {
+		hours = *expireHours
+	}
+	var expiresAt *time.Time
+	if hours > 0 {
+		t := time.Now().Add(time.Duration(hours) * time.Hour)
+		expiresAt = &t
+	}
+	return s.skipRepo.Save(userID, postID, expiresAt)
+}
+
+// ListSkips 获取审核员当前有效的跳过记录
+func (s *ReviewService) ListSkips(userID uint) ([]*models.ReviewSkip, error) {
+	return s.skipRepo.ListActiveByUser(userID, time.Now())
+}
+
+// Unskip 取消跳过，申请重新回到审核员的队列中
+func (s *ReviewService) Unskip(postID uint, userID uint) error {
+	if err := s.skipRepo.Delete(userID, postID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("未跳过该申请")
+		}
+		return err
+	}
+	return nil
+}
+
+// ListMostSkipped 获取被多名审核员跳过、仍在二级审核中的申请
+func (s *ReviewService) ListMostSkipped(minReviewers, limit int) ([]*dto.SkippedPostResponse, error) {
+	stats, err := s.skipRepo.ListMostSkipped(minReviewers, limit)
 	if err != nil {
 		return nil, err
 	}
 
-	// 锁定帖子
-	if err := s.postRepo.LockPost(post.ID, userID); err != nil {
-		return nil, errors.New("帖子已被其他审核员锁定")
+	ids := make([]uint, len(stats))
+	for i, stat := range stats {
+		ids[i] = stat.PostID
+	}
+	posts, err := s.postRepo.FindByIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uint]*models.Post, len(posts))
+	for _, post := range posts {
+		byID[post.ID] = post
 	}
 
-	return post, nil
+	list := make([]*dto.SkippedPostResponse, 0, len(stats))
+	for _, stat := range stats {
+		post, ok := byID[stat.PostID]
+		if !ok {
+			continue
+		}
+		resp := dto.ToPostResponse(post)
+		resp.ShowModerationInfo(post)
+		list = append(list, &dto.SkippedPostResponse{
+			Post:      resp,
+			Reviewers: stat.Reviewers,
+			Skips:     stat.Skips,
+		})
+	}
+	return list, nil
 }
 
-// SkipPost 跳过当前帖子（解锁）
-func (s *ReviewService) SkipPost(postID uint, userID uint) error {
-	return s.postRepo.UnlockPost(postID, userID)
+// Heartbeat 续期当前审核员持有的锁定，返回新的过期时间
+func (s *ReviewService) Heartbeat(postID uint, userID uint) (time.Time, error) {
+	lockTimeout := s.LockTimeout()
+	if err := s.postRepo.RenewLock(postID, userID, lockTimeout); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return time.Time{}, errors.New("锁定已失效，请重新获取待审核的申请")
+		}
+		return time.Time{}, err
+	}
+	return time.Now().Add(lockTimeout), nil
 }
 
-// CheckLockAndApprove 检查锁定状态并通过审核
-func (s *ReviewService) CheckLockAndApprove(postID uint, reviewerID uint, inviteCode string) error {
-	// 检查帖子是否被其他用户锁定
-	locked, err := s.postRepo.IsPostLocked(postID, reviewerID)
+// ListActiveLocks 获取当前有效的审核锁定
+func (s *ReviewService) ListActiveLocks() ([]*dto.ReviewLockResponse, error) {
+	locks, err := s.lockRepo.ListActive()
 	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	lockTimeout := s.LockTimeout()
+	list := make([]*dto.ReviewLockResponse, 0, len(locks))
+	for _, lock := range locks {
+		if !lockHeld(lock, now, lockTimeout) {
+			continue // 已失效但尚未被定时任务清理
+		}
+		resp := dto.ToReviewLockResponse(lock)
+		resp.AgeSeconds = int64(now.Sub(lock.LockedAt).Seconds())
+		resp.HeartbeatAt = lock.Post.LockedAt.Format("2006-01-02 15:04:05")
+		resp.ExpiresAt = lock.Post.LockedAt.Add(lockTimeout).Format("2006-01-02 15:04:05")
+		list = append(list, resp)
+	}
+	return list, nil
+}
+
+// ListLockHistory 获取已释放的审核锁定记录
+func (s *ReviewService) ListLockHistory(page, pageSize int) ([]*models.ReviewLock, int64, error) {
+	offset := (page - 1) * pageSize
+	return s.lockRepo.ListReleased(offset, pageSize)
+}
+
+// ForceRelease 管理员强制释放申请的审核锁定
+func (s *ReviewService) ForceRelease(postID uint, adminID uint) error {
+	post, err := s.postRepo.FindByID(postID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("帖子不存在")
+		}
 		return err
 	}
-	if locked {
-		return errors.New("帖子已被其他审核员锁定，请刷新后重试")
+	if post.LockedBy == nil {
+		return errors.New("该申请当前没有被锁定")
+	}
+
+	s.releaseLock(postID, *post.LockedBy, models.LockReleaseAdmin, &adminID)
+	return nil
+}
+
+// ExpireLocks 释放超时未续期的锁定，返回释放的数量
+// 申请已被撤回、重新开启等原因不再由该审核员持有时同样按过期处理
+func (s *ReviewService) ExpireLocks() (int, error) {
+	locks, err := s.lockRepo.ListActive()
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	lockTimeout := s.LockTimeout()
+	count := 0
+	for _, lock := range locks {
+		if lockHeld(lock, now, lockTimeout) {
+			continue
+		}
+		s.releaseLock(lock.PostID, lock.UserID, models.LockReleaseExpiry, nil)
+		count++
+	}
+	return count, nil
+}
+
+// StartLockExpiry 启动后台定时释放过期锁定
+func (s *ReviewService) StartLockExpiry(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			count, err := s.ExpireLocks()
+			if err != nil {
+				log.Printf("[ReviewService] 释放过期锁定失败: %v", err)
+				continue
+			}
+			if count > 0 {
+				log.Printf("[ReviewService] 已释放 %d 个过期的审核锁定", count)
+			}
+		}
+	}()
+}
+
+// CheckSLA 检查二级审核时限: 超过升级时限的申请标记为升级并通知管理员，
+// 超过兜底时限的申请按配置执行兜底操作，返回升级和执行兜底操作的数量
+func (s *ReviewService) CheckSLA() (escalated, fallback int, err error) {
+	now := time.Now()
+
+	escalateHours := s.configRepo.GetInt(models.ConfigReviewSLAEscalateHours, models.DefaultReviewSLAEscalateHours)
+	if escalateHours > 0 {
+		posts, err := s.postRepo.ListOverdueForEscalation(now.Add(-time.Duration(escalateHours) * time.Hour))
+		if err != nil {
+			return 0, 0, err
+		}
+		for _, post := range posts {
+			if err := s.postRepo.MarkEscalated(post.ID); err != nil {
+				continue
+			}
+			_ = s.eventRepo.Record(post.ID, 0, models.PostEventEscalate, fmt.Sprintf("二级审核超过 %d 小时未处理", escalateHours))
+			s.notifySLA(post, models.RoleAdmin, fmt.Sprintf("已在二级审核中等待超过 %d 小时，请尽快安排审核。", escalateHours))
+			escalated++
+		}
 	}
 
-	return s.ApproveWithNotification(postID, reviewerID, inviteCode)
+	fallbackHours := s.configRepo.GetInt(models.ConfigReviewSLAFallbackHours, models.DefaultReviewSLAFallbackHours)
+	if fallbackHours > 0 {
+		action := s.configRepo.GetString(models.ConfigReviewSLAFallbackAction, models.DefaultReviewSLAFallbackAction)
+		if action != models.SLAFallbackNotify && action != models.SLAFallbackReturn {
+			action = models.SLAFallbackWait
+		}
+
+		posts, err := s.postRepo.ListOverdueForFallback(now.Add(-time.Duration(fallbackHours) * time.Hour))
+		if err != nil {
+			return escalated, 0, err
+		}
+		for _, post := range posts {
+			err := s.postRepo.Transaction(func(tx *gorm.DB) error {
+				if err := s.postRepo.WithTx(tx).MarkSLAFallback(post.ID, action); err != nil {
+					return err
+				}
+				if action != models.SLAFallbackReturn {
+					return nil
+				}
+				// 退回后重新计票，本轮审核员意见作废，重新进入二级审核后重新收集
+				if err := s.voteRepo.WithTx(tx).DeleteByPost(post.ID); err != nil {
+					return err
+				}
+				if err := s.postRepo.WithTx(tx).UpdateVotes(post.ID, 0, 0); err != nil {
+					return err
+				}
+				if err := s.decisionRepo.WithTx(tx).CloseByPost(post.ID); err != nil {
+					return err
+				}
+				return s.lockRepo.WithTx(tx).CloseByPost(post.ID, models.LockReleaseSLA, nil)
+			})
+			if err != nil {
+				if !errors.Is(err, gorm.ErrRecordNotFound) {
+					log.Printf("[ReviewService] 申请 %d 执行审核时限兜底操作失败: %v", post.ID, err)
+				}
+				continue
+			}
+
+			note := fmt.Sprintf("二级审核超过 %d 小时未处理，", fallbackHours)
+			switch action {
+			case models.SLAFallbackNotify:
+				note += "已通知所有认证用户"
+				s.notifySLA(post, models.RoleCertified, fmt.Sprintf("已在二级审核中等待超过 %d 小时，请有空的审核员协助处理。", fallbackHours))
+			case models.SLAFallbackReturn:
+				note += "退回社区投票并重新计票"
+				if s.emailService != nil && post.User != nil && post.User.Email != "" {
+					_ = s.emailService.SendStatusNotification(post.User.Email, post.User.Username, post.Title,
+						dto.GetStatusText(models.StatusFirstReview), "由于长时间没有审核员处理，您的申请已退回社区投票。原有投票已清空，重新投票达到要求后将再次进入二级审核。")
+				}
+			default:
+				note += "继续等待审核"
+			}
+			_ = s.eventRepo.Record(post.ID, 0, models.PostEventSLAFallback, note)
+			fallback++
+		}
+	}
+
+	return escalated, fallback, nil
+}
+
+// StartSLACheck 启动后台定时检查二级审核时限
+func (s *ReviewService) StartSLACheck(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			escalated, fallback, err := s.CheckSLA()
+			if err != nil {
+				log.Printf("[ReviewService] 检查审核时限失败: %v", err)
+				continue
+			}
+			if escalated > 0 || fallback > 0 {
+				log.Printf("[ReviewService] 审核时限: 已升级 %d 个申请，已对 %d 个申请执行兜底操作", escalated, fallback)
+			}
+		}
+	}()
+}
+
+// notifySLA 邮件通知指定角色及以上的用户处理超时的申请
+func (s *ReviewService) notifySLA(post *models.Post, role models.UserRole, message string) {
+	if s.emailService == nil {
+		return
+	}
+	users, err := s.userRepo.ListByMinRole(role)
+	if err != nil {
+		log.Printf("[ReviewService] 获取审核时限通知对象失败: %v", err)
+		return
+	}
+
+	subject := fmt.Sprintf("申请「%s」审核超时", post.Title)
+	for _, user := range users {
+		// 申请者本人以及受限账号不需要通知
+		if user.Email == "" || user.ID == post.UserID || (role != models.RoleAdmin && !user.CanApprove()) {
+			continue
+		}
+		body := fmt.Sprintf(`%s 您好：
+
+邀请码申请「%s」(ID: %d)%s
+
+---
+此邮件由Linux.do Review系统自动发送，请勿回复。
+`, user.Username, post.Title, post.ID, message)
+		_ = s.emailService.SendNotification(user.Email, subject, body)
+	}
+}
+
+// LockTimeout 审核锁定时长
+func (s *ReviewService) LockTimeout() time.Duration {
+	minutes := s.configRepo.GetInt(models.ConfigReviewLockMinutes, models.DefaultReviewLockMinutes)
+	if minutes <= 0 {
+		minutes = models.DefaultReviewLockMinutes
+	}
+	return time.Duration(minutes) * time.Minute
+}
+
+// releaseLock 释放锁定并记录原因
+func (s *ReviewService) releaseLock(postID, userID uint, reason string, releasedBy *uint) {
+	_ = s.postRepo.UnlockPost(postID, userID)
+	_ = s.lockRepo.Close(postID, userID, reason, releasedBy)
+}
+
+// lockHeld 锁定记录对应的锁定是否仍然有效
+func lockHeld(lock *models.ReviewLock, now time.Time, lockTimeout time.Duration) bool {
+	post := lock.Post
+	return post != nil && post.Status == models.StatusSecondReview &&
+		post.LockedBy != nil && *post.LockedBy == lock.UserID &&
+		post.LockedAt != nil && now.Sub(*post.LockedAt) < lockTimeout
+}
+
+// CheckLockAndApprove 检查锁定状态并提交同意意见
+// 同意人数达到要求时由本次审核员提交邀请码完成审核，否则只记录意见并释放锁定
+func (s *ReviewService) CheckLockAndApprove(postID uint, reviewerID uint, inviteCode, note string) (*dto.ReviewConsensusResponse, error) {
+	post, err := s.checkDecision(postID, reviewerID)
+	if err != nil {
+		return nil, err
+	}
+	if post.Status != models.StatusSecondReview {
+		return nil, errors.New("帖子不在二级审核阶段")
+	}
+	if reason, err := s.conflicts.Check(reviewerID, post); err != nil {
+		return nil, errors.New("检查利益冲突失败")
+	} else if reason != "" {
+		return nil, errors.New("存在利益冲突，不能通过该申请: " + ConflictText(reason))
+	}
+
+	required, _ := s.getConsensusConfig()
+	approvals, rejections, err := s.decisionRepo.CountOpen(postID)
+	if err != nil {
+		return nil, err
+	}
+
+	decision := &models.ReviewDecision{
+		PostID:     postID,
+		ReviewerID: reviewerID,
+		Decision:   models.ReviewDecisionApprove,
+		Note:       note,
+	}
+
+	if int(approvals)+1 < required {
+		if err := s.decisionRepo.Create(decision); err != nil {
+			return nil, err
+		}
+		s.releaseLock(postID, reviewerID, models.LockReleaseDecision, nil)
+		resp, err := s.GetConsensus(postID)
+		if err != nil {
+			return nil, err
+		}
+		resp.Message = fmt.Sprintf("已记录同意意见(%d/%d)，等待其他审核员确认", resp.Approvals, resp.RequiredApprovals)
+		return resp, nil
+	}
+
+	// 最后一名同意的审核员提交邀请码
+	if inviteCode == "" {
+		return nil, errors.New("请提交邀请码")
+	}
+	if err := s.ApproveWithNotification(postID, reviewerID, inviteCode); err != nil {
+		return nil, err
+	}
+	decision.Closed = true
+	_ = s.decisionRepo.Create(decision)
+	s.releaseLock(postID, reviewerID, models.LockReleaseDecision, nil)
+
+	resp, err := s.GetConsensus(postID)
+	if err != nil {
+		return nil, err
+	}
+	// 本轮意见已关闭，返回结束时的统计
+	resp.Approvals, resp.Rejections = int(approvals)+1, int(rejections)
+	resp.Final = true
+	resp.Message = "审核通过，邀请码已发送给申请者"
+	return resp, nil
+}
+
+// CheckLockAndReject 检查锁定状态并提交否决意见
+// 二级审核中否决人数达到要求时拒绝申请，否则只记录意见并释放锁定；投票阶段的申请直接拒绝
+func (s *ReviewService) CheckLockAndReject(postID uint, userID uint, reason, note string) (*dto.ReviewConsensusResponse, error) {
+	post, err := s.checkDecision(postID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if post.Status != models.StatusSecondReview {
+		if err := s.RejectWithNotification(postID, userID, reason); err != nil {
+			return nil, err
+		}
+		return &dto.ReviewConsensusResponse{PostID: postID, Status: models.StatusRejected, Final: true, Message: "已拒绝"}, nil
+	}
+
+	_, veto := s.getConsensusConfig()
+	approvals, rejections, err := s.decisionRepo.CountOpen(postID)
+	if err != nil {
+		return nil, err
+	}
+
+	decision := &models.ReviewDecision{
+		PostID:     postID,
+		ReviewerID: userID,
+		Decision:   models.ReviewDecisionReject,
+		Note:       note,
+	}
+
+	if int(rejections)+1 < veto {
+		if err := s.decisionRepo.Create(decision); err != nil {
+			return nil, err
+		}
+		s.releaseLock(postID, userID, models.LockReleaseDecision, nil)
+		resp, err := s.GetConsensus(postID)
+		if err != nil {
+			return nil, err
+		}
+		resp.Message = fmt.Sprintf("已记录否决意见(%d/%d)", resp.Rejections, resp.VetoCount)
+		return resp, nil
+	}
+
+	if err := s.RejectWithNotification(postID, userID, reason); err != nil {
+		return nil, err
+	}
+	decision.Closed = true
+	_ = s.decisionRepo.Create(decision)
+	s.releaseLock(postID, userID, models.LockReleaseDecision, nil)
+
+	resp, err := s.GetConsensus(postID)
+	if err != nil {
+		return nil, err
+	}
+	resp.Approvals, resp.Rejections = int(approvals), int(rejections)+1
+	resp.Final = true
+	resp.Message = "已拒绝"
+	return resp, nil
+}
+
+// GetConsensus 获取申请的二级审核进度及所有审核意见
+func (s *ReviewService) GetConsensus(postID uint) (*dto.ReviewConsensusResponse, error) {
+	post, err := s.postRepo.FindByID(postID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("帖子不存在")
+		}
+		return nil, err
+	}
+
+	decisions, err := s.decisionRepo.ListByPost(postID)
+	if err != nil {
+		return nil, err
+	}
+
+	required, veto := s.getConsensusConfig()
+	resp := &dto.ReviewConsensusResponse{
+		PostID:            postID,
+		Status:            post.Status,
+		RequiredApprovals: required,
+		VetoCount:         veto,
+		Decisions:         make([]*dto.ReviewDecisionResponse, len(decisions)),
+	}
+	for i, decision := range decisions {
+		resp.Decisions[i] = dto.ToReviewDecisionResponse(decision)
+		if decision.Closed {
+			continue // 已结束轮次的意见只展示不计入进度
+		}
+		if decision.IsApprove() {
+			resp.Approvals++
+		} else {
+			resp.Rejections++
+		}
+	}
+	return resp, nil
 }
 
-// CheckLockAndReject 检查锁定状态并拒绝
-func (s *ReviewService) CheckLockAndReject(postID uint, userID uint, reason string) error {
+// AddNote 为申请添加审核员内部备注(申请者本人不能添加)
+func (s *ReviewService) AddNote(postID, userID uint, content string) (*models.ReviewerNote, error) {
+	post, err := s.postRepo.FindByID(postID)
+	if err != nil {
+		return nil, errors.New("帖子不存在")
+	}
+	if post.UserID == userID {
+		return nil, errors.New("不能为自己的申请添加审核备注")
+	}
+
+	note := &models.ReviewerNote{
+		PostID:  postID,
+		UserID:  userID,
+		Content: content,
+	}
+	if err := s.noteRepo.Create(note); err != nil {
+		return nil, errors.New("添加备注失败")
+	}
+	return note, nil
+}
+
+// ListNotes 获取申请的审核员内部备注(申请者本人不能查看)
+func (s *ReviewService) ListNotes(postID, viewerID uint) ([]*models.ReviewerNote, error) {
+	post, err := s.postRepo.FindByID(postID)
+	if err != nil {
+		return nil, errors.New("帖子不存在")
+	}
+	if post.UserID == viewerID {
+		return nil, errors.New("不能查看自己申请的审核备注")
+	}
+
+	notes, err := s.noteRepo.ListByPost(postID)
+	if err != nil {
+		return nil, errors.New("获取备注失败")
+	}
+	return notes, nil
+}
+
+// checkDecision 提交审核意见前的检查: 审核员账号、锁定状态、本轮是否已提交过意见
+func (s *ReviewService) checkDecision(postID, reviewerID uint) (*models.Post, error) {
+	if err := s.checkReviewer(reviewerID); err != nil {
+		return nil, err
+	}
+
 	// 检查帖子是否被其他用户锁定
-	locked, err := s.postRepo.IsPostLocked(postID, userID)
+	locked, err := s.postRepo.IsPostLocked(postID, reviewerID, s.LockTimeout())
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if locked {
-		return errors.New("帖子已被其他审核员锁定，请刷新后重试")
+		return nil, errors.New("帖子已被其他审核员锁定，请刷新后重试")
 	}
 
-	return s.RejectWithNotification(postID, reason)
+	post, err := s.postRepo.FindByID(postID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("帖子不存在")
+		}
+		return nil, err
+	}
+
+	if post.Status == models.StatusSecondReview {
+		existing, err := s.decisionRepo.FindOpen(postID, reviewerID)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return nil, errors.New("您已对该申请提交过审核意见")
+		}
+	}
+	return post, nil
 }
 
 // GetReviewCount 获取待二级审核的数量
@@ -239,6 +961,19 @@ func (s *ReviewService) GetReviewCount() (int64, error) {
 	return s.postRepo.CountForSecondReview()
 }
 
+// getConsensusConfig 获取多人审核配置: 通过所需的同意人数和拒绝所需的否决人数(均至少为1)
+func (s *ReviewService) getConsensusConfig() (required, veto int) {
+	required = s.configRepo.GetInt(models.ConfigReviewRequiredApprovals, models.DefaultReviewRequiredApprovals)
+	veto = s.configRepo.GetInt(models.ConfigReviewVetoCount, models.DefaultReviewVetoCount)
+	if required < 1 {
+		required = 1
+	}
+	if veto < 1 {
+		veto = 1
+	}
+	return
+}
+
 // getReviewConfig 获取审核配置
 func (s *ReviewService) getReviewConfig() (minVotes int, approvalRate int) {
 	// 默认值
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+
+	"linuxdo-review/models"
+	"linuxdo-review/pkg/similarity"
+	"linuxdo-review/repository"
+)
+
+const (
+	maxSimilarMatches   = 5  // 每个帖子最多保存的相似匹配数
+	minStoredSimilarity = 30 // 低于该相似度(百分比)的匹配不保存
+)
+
+// SimilarityService 重复/抄袭检测服务
+type SimilarityService struct {
+	similarityRepo *repository.SimilarityRepository
+	postRepo       *repository.PostRepository
+	configRepo     *repository.ConfigRepository
+	eventRepo      *repository.PostEventRepository
+}
+
+// NewSimilarityService 创建重复检测服务
+func NewSimilarityService(
+	similarityRepo *repository.SimilarityRepository,
+	postRepo *repository.PostRepository,
+	configRepo *repository.ConfigRepository,
+	eventRepo *repository.PostEventRepository,
+) *SimilarityService {
+	return &SimilarityService{
+		similarityRepo: similarityRepo,
+		postRepo:       postRepo,
+		configRepo:     configRepo,
+		eventRepo:      eventRepo,
+	}
+}
+
+// Check 计算帖子与其他用户历史帖子的相似度，保存最相似的匹配
+// 相似度超过暂停阈值时将投票中的帖子暂停，返回是否被暂停
+func (s *SimilarityService) Check(post *models.Post) (bool, error) {
+	sig := similarity.Compute(post.Title + "\n" + post.Content)
+	if err := s.similarityRepo.SaveSignature(&models.PostSignature{
+		PostID:    post.ID,
+		UserID:    post.UserID,
+		Signature: sig.Encode(),
+	}); err != nil {
+		return false, err
+	}
+
+	others, err := s.similarityRepo.ListSignaturesExcludingUser(post.UserID)
+	if err != nil {
+		return false, err
+	}
+
+	var matches []*models.PostSimilarity
+	for _, other := range others {
+		score := similarity.Similarity(sig, similarity.Decode(other.Signature)) * 100
+		if score < minStoredSimilarity {
+			continue
+		}
+		matches = append(matches, &models.PostSimilarity{
+			PostID:      post.ID,
+			MatchPostID: other.PostID,
+			Score:       score,
+		})
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+	if len(matches) > maxSimilarMatches {
+		matches = matches[:maxSimilarMatches]
+	}
+
+	if err := s.similarityRepo.ReplaceMatches(post.ID, matches); err != nil {
+		return false, err
+	}
+
+	topScore := float64(0)
+	if len(matches) > 0 {
+		topScore = matches[0].Score
+	}
+	warnThreshold := s.configRepo.GetFloat(models.ConfigSimilarityWarnThreshold, models.DefaultSimilarityWarnThreshold)
+	possibleDuplicate := len(matches) > 0 && topScore >= warnThreshold
+	if err := s.postRepo.UpdateSimilarity(post.ID, topScore, possibleDuplicate); err != nil {
+		return false, err
+	}
+	post.SimilarityScore = topScore
+	post.PossibleDuplicate = possibleDuplicate
+
+	// 高度相似的申请暂停投票，等待管理员处理
+	holdThreshold := s.configRepo.GetFloat(models.ConfigSimilarityHoldThreshold, models.DefaultSimilarityHoldThreshold)
+	if holdThreshold <= 0 || topScore < holdThreshold || post.Status != models.StatusFirstReview {
+		return false, nil
+	}
+	note := fmt.Sprintf("与帖子 #%d 的相似度为 %.0f%%，已暂停投票", matches[0].MatchPostID, topScore)
+	if err := s.postRepo.Hold(post.ID, note); err != nil {
+		return false, err
+	}
+	post.Status = models.StatusPending
+	post.HoldReason = note
+	_ = s.eventRepo.Record(post.ID, 0, models.PostEventHold, note)
+
+	return true, nil
+}
+
+// BackfillSignatures 为功能上线前的帖子补充签名(不进行匹配)，返回补充数量
+func (s *SimilarityService) BackfillSignatures() (int, error) {
+	const batchSize = 200
+
+	count := 0
+	for {
+		posts, err := s.similarityRepo.ListPostsWithoutSignature(batchSize)
+		if err != nil {
+			return count, err
+		}
+		for _, post := range posts {
+			sig := similarity.Compute(post.Title + "\n" + post.Content)
+			if err := s.similarityRepo.SaveSignature(&models.PostSignature{
+				PostID:    post.ID,
+				UserID:    post.UserID,
+				Signature: sig.Encode(),
+			}); err != nil {
+				return count, err
+			}
+			count++
+		}
+		if len(posts) < batchSize {
+			return count, nil
+		}
+	}
+}
+
+// ListMatches 获取帖子的相似匹配
+func (s *SimilarityService) ListMatches(postID uint) ([]*models.PostSimilarity, error) {
+	return s.similarityRepo.ListMatches(postID)
+}
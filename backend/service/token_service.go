@@ -0,0 +1,161 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"linuxdo-review/dto"
+	"linuxdo-review/models"
+	"linuxdo-review/repository"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// maxTokensPerUser 每个用户最多可创建的令牌数
+	maxTokensPerUser = 20
+	// tokenLastUsedInterval 最后使用时间的更新间隔(避免每次请求都写库)
+	tokenLastUsedInterval = time.Minute
+)
+
+// TokenService 个人访问令牌服务
+type TokenService struct {
+	tokenRepo *repository.TokenRepository
+	userRepo  *repository.UserRepository
+	auth      *AuthService
+}
+
+// NewTokenService 创建个人访问令牌服务
+func NewTokenService(tokenRepo *repository.TokenRepository, userRepo *repository.UserRepository, auth *AuthService) *TokenService {
+	return &TokenService{
+		tokenRepo: tokenRepo,
+		userRepo:  userRepo,
+		auth:      auth,
+	}
+}
+
+// Create 创建令牌，返回令牌记录和明文令牌(明文只返回这一次)
+func (s *TokenService) Create(userID uint, req *dto.CreateTokenRequest) (*models.APIToken, string, error) {
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return nil, "", errors.New("用户不存在")
+	}
+
+	count, err := s.tokenRepo.CountByUserID(userID)
+	if err != nil {
+		return nil, "", errors.New("检查令牌数量失败")
+	}
+	if count >= maxTokensPerUser {
+		return nil, "", errors.New("令牌数量已达上限，请先删除不再使用的令牌")
+	}
+
+	scopes := make([]string, 0, len(req.Scopes))
+	seen := make(map[string]bool)
+	for _, scope := range req.Scopes {
+		if !models.IsValidScope(scope) {
+			return nil, "", errors.New("无效的权限范围: " + scope)
+		}
+		if seen[scope] {
+			continue
+		}
+		seen[scope] = true
+
+		// 令牌权限不能超出用户本身的权限
+		switch scope {
+		case models.ScopeReview:
+			if !user.IsAdmin() && user.TrustLevel < 3 {
+				return nil, "", errors.New("只有认证用户可以创建审核权限的令牌")
+			}
+		case models.ScopeAdminRead:
+			if !user.IsAdmin() {
+				return nil, "", errors.New("只有管理员可以创建管理后台权限的令牌")
+			}
+		}
+		scopes = append(scopes, scope)
+	}
+
+	raw, err := generateAPIToken()
+	if err != nil {
+		return nil, "", errors.New("生成令牌失败")
+	}
+
+	token := &models.APIToken{
+		UserID:      userID,
+		Name:        req.Name,
+		TokenHash:   hashAPIToken(raw),
+		TokenPrefix: raw[:len(models.APITokenPrefix)+6],
+		Scopes:      strings.Join(scopes, ","),
+	}
+	if req.ExpiresInDays > 0 {
+		expiresAt := time.Now().AddDate(0, 0, req.ExpiresInDays)
+		token.ExpiresAt = &expiresAt
+	}
+
+	if err := s.tokenRepo.Create(token); err != nil {
+		return nil, "", errors.New("创建令牌失败")
+	}
+
+	return token, raw, nil
+}
+
+// List 获取用户的令牌列表
+func (s *TokenService) List(userID uint) ([]*models.APIToken, error) {
+	return s.tokenRepo.ListByUserID(userID)
+}
+
+// Revoke 删除(吊销)令牌
+func (s *TokenService) Revoke(userID, tokenID uint) error {
+	if err := s.tokenRepo.DeleteByIDAndUser(tokenID, userID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("令牌不存在")
+		}
+		return errors.New("删除令牌失败")
+	}
+	return nil
+}
+
+// AuthenticateToken 校验明文令牌，返回令牌所属用户和权限范围
+func (s *TokenService) AuthenticateToken(raw string) (*models.User, []string, error) {
+	token, err := s.tokenRepo.FindByHash(hashAPIToken(raw))
+	if err != nil || token.User == nil {
+		return nil, nil, errors.New("令牌无效")
+	}
+
+	if token.IsExpired() {
+		return nil, nil, errors.New("令牌已过期")
+	}
+
+	// 与登录一致：配置禁止受限账号登录时，已有令牌同样不能使用
+	if err := s.auth.checkLinuxDoRestriction(token.User); err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) > tokenLastUsedInterval {
+		if err := s.tokenRepo.UpdateLastUsed(token.ID, now); err != nil {
+			log.Printf("[TokenService] 更新令牌 %d 的最后使用时间失败: %v", token.ID, err)
+		}
+	}
+
+	return token.User, token.ScopeList(), nil
+}
+
+// generateAPIToken 生成带前缀的随机令牌
+func generateAPIToken() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return models.APITokenPrefix + hex.EncodeToString(b), nil
+}
+
+// hashAPIToken 计算令牌的SHA-256哈希
+func hashAPIToken(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,262 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"linuxdo-review/models"
+	"linuxdo-review/pkg/collusion"
+	"linuxdo-review/repository"
+
+	"gorm.io/gorm"
+)
+
+// voteRingLookback 投票圈分析的时间范围
+const voteRingLookback = 90 * 24 * time.Hour
+
+// VoteRingService 投票圈(串通投票)检测服务
+type VoteRingService struct {
+	clusterRepo *repository.VoteClusterRepository
+	voteRepo    *repository.VoteRepository
+	postRepo    *repository.PostRepository
+	userRepo    *repository.UserRepository
+	configRepo  *repository.ConfigRepository
+}
+
+// NewVoteRingService 创建投票圈检测服务
+func NewVoteRingService(
+	clusterRepo *repository.VoteClusterRepository,
+	voteRepo *repository.VoteRepository,
+	postRepo *repository.PostRepository,
+	userRepo *repository.UserRepository,
+	configRepo *repository.ConfigRepository,
+) *VoteRingService {
+	return &VoteRingService{
+		clusterRepo: clusterRepo,
+		voteRepo:    voteRepo,
+		postRepo:    postRepo,
+		userRepo:    userRepo,
+		configRepo:  configRepo,
+	}
+}
+
+// Analyze 分析近期投票，用新发现的投票圈替换待处理列表，返回发现的数量
+// 与已处理(作废或忽略)的投票圈成员完全相同的群体不会重复上报
+func (s *VoteRingService) Analyze() (int, error) {
+	votes, err := s.voteRepo.ListCountedSince(time.Now().Add(-voteRingLookback))
+	if err != nil {
+		return 0, err
+	}
+
+	records := make([]collusion.Vote, len(votes))
+	for i, v := range votes {
+		records[i] = collusion.Vote{UserID: v.UserID, PostID: v.PostID, VoteType: int(v.VoteType), At: v.CreatedAt}
+	}
+
+	opts := collusion.Options{
+		MinShared:     s.configRepo.GetInt(models.ConfigVoteRingMinShared, models.DefaultVoteRingMinShared),
+		MinAgreement:  s.configRepo.GetFloat(models.ConfigVoteRingMinAgreement, models.DefaultVoteRingMinAgreement),
+		TimeWindow:    time.Duration(s.configRepo.GetInt(models.ConfigVoteRingWindowSeconds, models.DefaultVoteRingWindowSeconds)) * time.Second,
+		MinTimingRate: s.configRepo.GetFloat(models.ConfigVoteRingMinTimingRate, models.DefaultVoteRingMinTimingRate),
+	}
+
+	handled, err := s.clusterRepo.ListHandled()
+	if err != nil {
+		return 0, err
+	}
+	known := make(map[string]bool, len(handled))
+	for _, c := range handled {
+		known[memberKey(c.MemberIDs)] = true
+	}
+
+	var clusters []*models.VoteCluster
+	for _, c := range collusion.Analyze(records, opts) {
+		if known[memberKey(c.Members)] {
+			continue
+		}
+		clusters = append(clusters, buildVoteCluster(c, votes))
+	}
+
+	if err := s.clusterRepo.ReplaceOpen(clusters); err != nil {
+		return 0, err
+	}
+	return len(clusters), nil
+}
+
+// StartAnalysis 启动后台定时分析
+func (s *VoteRingService) StartAnalysis(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			count, err := s.Analyze()
+			if err != nil {
+				log.Printf("[VoteRingService] 投票圈分析失败: %v", err)
+				continue
+			}
+			if count > 0 {
+				log.Printf("[VoteRingService] 发现 %d 个待处理的投票圈", count)
+			}
+		}
+	}()
+}
+
+// List 获取投票圈列表
+func (s *VoteRingService) List(status *int, page, pageSize int) ([]*models.VoteCluster, int64, error) {
+	offset := (page - 1) * pageSize
+	return s.clusterRepo.List(status, offset, pageSize)
+}
+
+// GetByID 获取投票圈详情及成员
+func (s *VoteRingService) GetByID(id uint) (*models.VoteCluster, []*models.User, error) {
+	cluster, err := s.findCluster(id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	members, err := s.userRepo.FindByIDs(cluster.MemberIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cluster, members, nil
+}
+
+// Discount 作废投票圈成员在相关申请上的投票(仅限仍在待审核或投票中的申请)，返回作废的投票数
+// 同时记录作废，成员之后在这些申请上修改或重新投票也不计票
+func (s *VoteRingService) Discount(id, adminID uint) (int, error) {
+	cluster, err := s.findOpen(id)
+	if err != nil {
+		return 0, err
+	}
+
+	// 作废投票、重新统计票数和处理投票圈在同一事务中完成，任一步失败时投票圈保持待处理以便重试
+	var count int64
+	err = s.postRepo.Transaction(func(tx *gorm.DB) error {
+		voteRepo := s.voteRepo.WithTx(tx)
+		postRepo := s.postRepo.WithTx(tx)
+		clusterRepo := s.clusterRepo.WithTx(tx)
+
+		postIDs, discounted, err := voteRepo.DiscountOnOpenPosts(cluster.MemberIDs, cluster.PostIDs, voteRingNote(cluster.ID))
+		if err != nil {
+			return err
+		}
+		count = discounted
+
+		for _, postID := range postIDs {
+			upVotes, downVotes, err := voteRepo.CountByPost(postID)
+			if err != nil {
+				return err
+			}
+			if err := postRepo.UpdateVotes(postID, int(upVotes), int(downVotes)); err != nil {
+				return err
+			}
+		}
+
+		if err := clusterRepo.CreateDiscounts(cluster.ID, cluster.MemberIDs, cluster.PostIDs); err != nil {
+			return err
+		}
+		return clusterRepo.Decide(cluster.ID, models.VoteClusterDiscounted, adminID, int(count))
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, errors.New("该投票圈已处理")
+		}
+		return 0, errors.New("作废投票失败")
+	}
+	return int(count), nil
+}
+
+// voteRingNote 投票圈成员投票不计票的说明
+func voteRingNote(clusterID uint) string {
+	return fmt.Sprintf("疑似投票圈 #%d，管理员已作废", clusterID)
+}
+
+// Dismiss 忽略投票圈(误报)
+func (s *VoteRingService) Dismiss(id, adminID uint) error {
+	cluster, err := s.findOpen(id)
+	if err != nil {
+		return err
+	}
+	if err := s.clusterRepo.Decide(cluster.ID, models.VoteClusterDismissed, adminID, 0); err != nil {
+		return errors.New("该投票圈已处理")
+	}
+	return nil
+}
+
+func (s *VoteRingService) findCluster(id uint) (*models.VoteCluster, error) {
+	cluster, err := s.clusterRepo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("投票圈不存在")
+		}
+		return nil, err
+	}
+	return cluster, nil
+}
+
+func (s *VoteRingService) findOpen(id uint) (*models.VoteCluster, error) {
+	cluster, err := s.findCluster(id)
+	if err != nil {
+		return nil, err
+	}
+	if !cluster.IsOpen() {
+		return nil, errors.New("该投票圈已处理")
+	}
+	return cluster, nil
+}
+
+// buildVoteCluster 汇总群体的统计数据，并找出至少两名成员投了相同票的申请
+func buildVoteCluster(c collusion.Cluster, votes []*models.Vote) *models.VoteCluster {
+	cluster := &models.VoteCluster{
+		MemberIDs: c.Members,
+		Status:    models.VoteClusterOpen,
+	}
+	for _, p := range c.Pairs {
+		cluster.Pairs = append(cluster.Pairs, models.VoteClusterPair{
+			UserA:      p.UserA,
+			UserB:      p.UserB,
+			Shared:     p.Shared,
+			Agreement:  p.Agreement,
+			TimingRate: p.TimingRate,
+		})
+		cluster.AvgAgreement += p.Agreement
+		cluster.AvgTiming += p.TimingRate
+	}
+	if len(c.Pairs) > 0 {
+		cluster.AvgAgreement /= float64(len(c.Pairs))
+		cluster.AvgTiming /= float64(len(c.Pairs))
+	}
+
+	members := make(map[uint]bool, len(c.Members))
+	for _, id := range c.Members {
+		members[id] = true
+	}
+	type postVote struct {
+		postID   uint
+		voteType models.VoteType
+	}
+	counts := make(map[postVote]int)
+	for _, v := range votes {
+		if members[v.UserID] {
+			counts[postVote{v.PostID, v.VoteType}]++
+		}
+	}
+	seen := make(map[uint]bool)
+	for key, n := range counts {
+		if n >= 2 && !seen[key.postID] {
+			seen[key.postID] = true
+			cluster.PostIDs = append(cluster.PostIDs, key.postID)
+		}
+	}
+	sort.Slice(cluster.PostIDs, func(i, j int) bool { return cluster.PostIDs[i] < cluster.PostIDs[j] })
+
+	return cluster
+}
+
+// memberKey 成员列表的唯一标识(成员已按ID升序)
+func memberKey(ids []uint) string {
+	return fmt.Sprint(ids)
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"testing"
+
+	"linuxdo-review/dto"
+	"linuxdo-review/models"
+	"linuxdo-review/repository"
+
+	"gorm.io/gorm"
+)
+
+func newTestVoteRingService(db *gorm.DB) *VoteRingService {
+	return NewVoteRingService(
+		repository.NewVoteClusterRepository(db), repository.NewVoteRepository(db), repository.NewPostRepository(db),
+		repository.NewUserRepository(db), repository.NewConfigRepository(db),
+	)
+}
+
+// discountedRing 创建两名成员都已在帖子上投赞成票的投票圈并作废，另有一名尚未投票的成员
+func discountedRing(t *testing.T, db *gorm.DB) (posts *PostService, post *models.Post, members []*models.User) {
+	t.Helper()
+	posts = newTestPostService(db)
+	author := createUser(t, db, "author", 3, models.RoleCertified)
+	post = createVotingPost(t, db, author.ID)
+	for _, name := range []string{"ring-a", "ring-b", "ring-c"} {
+		members = append(members, createUser(t, db, name, 2, models.RoleNormal))
+	}
+	for _, m := range members[:2] {
+		if err := posts.Vote(post.ID, m.ID, &dto.VoteRequest{VoteType: int(models.VoteUp)}); err != nil {
+			t.Fatalf("vote: %v", err)
+		}
+	}
+
+	cluster := &models.VoteCluster{
+		MemberIDs: []uint{members[0].ID, members[1].ID, members[2].ID},
+		PostIDs:   []uint{post.ID},
+		Status:    models.VoteClusterOpen,
+	}
+	if err := db.Create(cluster).Error; err != nil {
+		t.Fatalf("create cluster: %v", err)
+	}
+
+	count, err := newTestVoteRingService(db).Discount(cluster.ID, 1)
+	if err != nil {
+		t.Fatalf("discount: %v", err)
+	}
+	if count != 2 {
+		t.Fatalf("discounted = %d, want 2", count)
+	}
+	return posts, post, members
+}
+
+func TestVoteRingDiscount(t *testing.T) {
+	db := newTestDB(t)
+	_, post, members := discountedRing(t, db)
+
+	for _, m := range members[:2] {
+		vote := findVote(t, db, post.ID, m.ID)
+		if !vote.Ineligible || vote.IneligibleCode != models.IneligibleVoteRing {
+			t.Errorf("user %d vote ineligible = %v (%q), want vote ring", m.ID, vote.Ineligible, vote.IneligibleCode)
+		}
+	}
+
+	reloaded, _ := repository.NewPostRepository(db).FindByID(post.ID)
+	if reloaded.UpVotes != 0 || reloaded.DownVotes != 0 {
+		t.Errorf("post votes = %d/%d, want 0/0", reloaded.UpVotes, reloaded.DownVotes)
+	}
+
+	var cluster models.VoteCluster
+	db.First(&cluster)
+	if cluster.Status != models.VoteClusterDiscounted || cluster.Discounted != 2 {
+		t.Errorf("cluster status = %d discounted = %d, want discounted/2", cluster.Status, cluster.Discounted)
+	}
+	if _, err := newTestVoteRingService(db).Discount(cluster.ID, 1); err == nil {
+		t.Error("discounting a handled cluster should fail")
+	}
+}
+
+func TestVoteAfterRingDiscount(t *testing.T) {
+	tests := []struct {
+		name      string
+		member    int // 投票的成员下标，-1 表示非成员
+		votes     []models.VoteType
+		wantCode  string
+		wantCount int // 帖子最终的有效票数
+	}{
+		{"修改投票", 0, []models.VoteType{models.VoteDown}, models.IneligibleVoteRing, 0},
+		{"取消后重新投票", 0, []models.VoteType{models.VoteUp, models.VoteUp}, models.IneligibleVoteRing, 0},
+		{"未投过票的成员首次投票", 2, []models.VoteType{models.VoteUp}, models.IneligibleVoteRing, 0},
+		{"非成员投票", -1, []models.VoteType{models.VoteUp}, "", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newTestDB(t)
+			posts, post, members := discountedRing(t, db)
+
+			voter := createUser(t, db, "outsider", 2, models.RoleNormal)
+			if tt.member >= 0 {
+				voter = members[tt.member]
+			}
+			for _, voteType := range tt.votes {
+				if err := posts.Vote(post.ID, voter.ID, &dto.VoteRequest{VoteType: int(voteType)}); err != nil {
+					t.Fatalf("vote: %v", err)
+				}
+			}
+
+			vote := findVote(t, db, post.ID, voter.ID)
+			if vote.IneligibleCode != tt.wantCode || vote.Ineligible != (tt.wantCode != "") {
+				t.Errorf("vote ineligible = %v (%q), want code %q", vote.Ineligible, vote.IneligibleCode, tt.wantCode)
+			}
+			reloaded, _ := posts.postRepo.FindByID(post.ID)
+			if got := reloaded.UpVotes + reloaded.DownVotes; got != tt.wantCount {
+				t.Errorf("post counted votes = %d, want %d", got, tt.wantCount)
+			}
+		})
+	}
+}
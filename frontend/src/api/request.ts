@@ -2,6 +2,20 @@ import axios, { type AxiosInstance, type AxiosResponse, type InternalAxiosReques
 import { message } from 'ant-design-vue'
 import type { ApiResponse } from '@/types'
 
+const DEVICE_ID_KEY = 'device_id'
+
+// 获取当前浏览器的设备标识(首次使用时随机生成并保存，用于审核利益冲突检测)
+const getDeviceFingerprint = (): string => {
+  let deviceId = localStorage.getItem(DEVICE_ID_KEY)
+  if (!deviceId) {
+    deviceId = typeof crypto !== 'undefined' && typeof crypto.randomUUID === 'function'
+      ? crypto.randomUUID()
+      : `${Date.now().toString(36)}-${Math.random().toString(36).slice(2)}`
+    localStorage.setItem(DEVICE_ID_KEY, deviceId)
+  }
+  return deviceId
+}
+
 // 创建axios实例
 const request: AxiosInstance = axios.create({
   baseURL: '/api',
@@ -18,6 +32,9 @@ request.interceptors.request.use(
     if (token && config.headers) {
       config.headers.Authorization = `Bearer ${token}`
     }
+    if (config.headers) {
+      config.headers['X-Device-Fingerprint'] = getDeviceFingerprint()
+    }
     return config
   },
   (error) => {
@@ -9,6 +9,8 @@ export interface User {
   linuxdo_username?: string
   avatar_url?: string
   trust_level?: number
+  linuxdo_silenced?: boolean
+  linuxdo_inactive?: boolean
   is_certified?: boolean
   is_admin?: boolean
   created_at: string